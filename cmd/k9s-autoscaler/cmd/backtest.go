@@ -0,0 +1,51 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package cmd
+
+import (
+	"context"
+	controllercmd "k9s-autoscaler/pkg/cmd"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+)
+
+var BacktestCMD = &cobra.Command{
+	Use:   "backtest",
+	Short: "Replay recorded metrics against an autoscaler offline",
+
+	Run: runBacktest,
+}
+
+var (
+	backtestOpts = controllercmd.NewBacktestOptions()
+)
+
+func init() {
+	BacktestCMD.Flags().StringVar(&backtestOpts.AutoscalerPath, "autoscaler", backtestOpts.AutoscalerPath, "path to yaml autoscaler definition")
+	BacktestCMD.MarkFlagFilename("autoscaler")
+	BacktestCMD.MarkFlagRequired("autoscaler")
+	BacktestCMD.Flags().StringToStringVar(&backtestOpts.MetricPaths, "metric", backtestOpts.MetricPaths, "recorded metric time series as name=path to csv or json file, may be repeated")
+	BacktestCMD.MarkFlagRequired("metric")
+	BacktestCMD.Flags().BoolVar(&backtestOpts.Linear, "linear", backtestOpts.Linear, "linearly interpolate metric values between recorded points")
+	BacktestCMD.Flags().StringVar(&backtestOpts.OutputPath, "output", backtestOpts.OutputPath, "path of timeline output, stdout if empty")
+	BacktestCMD.Flags().StringVar(&backtestOpts.Format, "format", backtestOpts.Format, "timeline output format, csv or json")
+	BacktestCMD.Flags().DurationVar(&backtestOpts.ResyncPeriod, "resync-period", backtestOpts.ResyncPeriod, "autoscaler resync period")
+	BacktestCMD.Flags().DurationVar(&backtestOpts.DownscaleStabilizationWindow, "downscale-stabilization", backtestOpts.DownscaleStabilizationWindow, "downscale stabilization window")
+	BacktestCMD.Flags().Float64Var(&backtestOpts.Tolerance, "tolerance", backtestOpts.Tolerance, "scaling tolerance")
+	BacktestCMD.Flags().DurationVar(&backtestOpts.Step, "step", backtestOpts.Step, "timeline interval, defaults to resync period")
+	BacktestCMD.Flags().Int32Var(&backtestOpts.InitialScale, "initial-scale", backtestOpts.InitialScale, "scale at start of backtest, defaults to autoscaler min")
+	RootCMD.AddCommand(BacktestCMD)
+}
+
+func runBacktest(command *cobra.Command, args []string) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := controllercmd.RunBacktest(ctx, backtestOpts); err != nil {
+		klog.Exitf("backtest failed: %v", err)
+	}
+}
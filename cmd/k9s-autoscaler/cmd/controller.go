@@ -29,6 +29,8 @@ func init() {
 	ControllerCMD.MarkFlagFilename("config")
 	ControllerCMD.MarkFlagRequired("config")
 	ControllerCMD.Flags().IntVar(&opts.Workers, "workers", opts.Workers, "number of controller workers")
+	ControllerCMD.Flags().StringVar(&opts.APIListenAddress, "api-listen", opts.APIListenAddress, "autoscaler REST API listen address, disabled if empty")
+	ControllerCMD.Flags().StringVar(&opts.GRPCListenAddress, "grpc-listen", opts.GRPCListenAddress, "autoscaler gRPC API listen address, disabled if empty")
 	RootCMD.AddCommand(ControllerCMD)
 }
 
@@ -40,7 +42,11 @@ func runController(command *cobra.Command, args []string) {
 	if err != nil {
 		klog.Exitf("failed to start controller: %v", err)
 	}
-	go c.Start()
+	go func() {
+		if err := c.Start(); err != nil {
+			klog.Exitf("failed to start controller: %v", err)
+		}
+	}()
 
 	klog.InfoS("startup sequence completed")
 	sigs := make(chan os.Signal, 1)
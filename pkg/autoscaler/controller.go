@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	"k9s-autoscaler/pkg/autoscaler/hpa"
 	"k9s-autoscaler/pkg/autoscaler/types"
 	"k9s-autoscaler/pkg/scale"
 	"k9s-autoscaler/pkg/storage"
@@ -14,9 +15,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	autoscalinginformers "k8s.io/client-go/informers/autoscaling/v2"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	v2listers "k8s.io/client-go/listers/autoscaling/v2"
 	scaleclient "k8s.io/client-go/scale"
-	"k8s.io/kubernetes/pkg/controller/podautoscaler"
 	metricsclient "k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
+	"k8s.io/utils/clock"
 )
 
 // A thin wrapper around HorizontalController that implments convenience
@@ -28,7 +30,14 @@ type controller struct {
 
 	storageClient *storage.Client
 	hpaInformer   *storage.HPAInformer
-	k8sController *podautoscaler.HorizontalController
+	k8sController *hpa.HorizontalController
+}
+
+// An HPA informer whose lister reads directly from storage, such that the HPA
+// always sees the status of its last reconcile.
+type directHPAInformer struct {
+	*storage.HPAInformer
+	lister v2listers.HorizontalPodAutoscalerLister
 }
 
 // Create a new controller with provided adapters. Controller needs to be started
@@ -42,6 +51,30 @@ func NewController(
 	downscaleStabilisationWindow time.Duration,
 	tolerance float64) types.Controller {
 
+	return NewControllerWithClock(
+		storageClient,
+		evtNamespacer,
+		scaleNamespacer,
+		metricsClient,
+		resyncPeriod,
+		downscaleStabilisationWindow,
+		tolerance,
+		clock.RealClock{})
+}
+
+// Create a new controller like NewController() whose HPA keeps time with
+// clock. Controllers with a fake clock are driven by stepping the clock and
+// calling Sync() rather than Run().
+func NewControllerWithClock(
+	storageClient *storage.Client,
+	evtNamespacer v1core.EventsGetter,
+	scaleNamespacer scaleclient.ScalesGetter,
+	metricsClient metricsclient.MetricsClient,
+	resyncPeriod time.Duration,
+	downscaleStabilisationWindow time.Duration,
+	tolerance float64,
+	clock clock.PassiveClock) types.Controller {
+
 	c := &controller{
 		storageClient: storageClient,
 		hpaInformer:   storage.NewInformer(storageClient),
@@ -56,20 +89,23 @@ func NewController(
 	delayOfInitialReadinessStatus := 30 * time.Second
 	containerResourceMetricsEnabled := false
 
-	c.k8sController = podautoscaler.NewHorizontalController(
+	metricsClient = newFallbackMetricsClient(storageClient, scaleNamespacer, metricsClient)
+
+	c.k8sController = hpa.NewHorizontalController(
 		evtNamespacer,
 		scaleNamespacer,
 		storageClient,
 		mapper,
 		metricsClient,
-		c.hpaInformer,
+		&directHPAInformer{HPAInformer: c.hpaInformer, lister: storage.NewDirectLister(storageClient)},
 		podInformer,
 		resyncPeriod,
 		downscaleStabilisationWindow,
 		tolerance,
 		cpuInitializationPeriod,
 		delayOfInitialReadinessStatus,
-		containerResourceMetricsEnabled)
+		containerResourceMetricsEnabled,
+		clock)
 
 	return c
 }
@@ -80,6 +116,10 @@ func (c *controller) Run(ctx context.Context, workers int) {
 	c.k8sController.Run(ctx, workers)
 }
 
+func (c *controller) Sync(ctx context.Context) error {
+	return c.k8sController.Sync(ctx)
+}
+
 func (c *controller) RESTMappings(gk schema.GroupKind, versions ...string) ([]*apimeta.RESTMapping, error) {
 	return []*apimeta.RESTMapping{
 		{
@@ -90,3 +130,7 @@ func (c *controller) RESTMappings(gk schema.GroupKind, versions ...string) ([]*a
 		},
 	}, nil
 }
+
+func (i *directHPAInformer) Lister() v2listers.HorizontalPodAutoscalerLister {
+	return i.lister
+}
@@ -34,6 +34,7 @@ func TestAutoscalerControllerNoScale(t *testing.T) {
 
 	storageClient, err := storage.NewClient(autoscalerUpdateMock)
 	require.NoError(t, err)
+	defer storageClient.Close()
 
 	eventerMock := eventsmocks.NewMockEventCreator(mockCtrl)
 	eventerMock.EXPECT().Create(gomock.Any(), t.Name(), t.Name(), gomock.Any()).DoAndReturn(
@@ -63,10 +64,10 @@ func TestAutoscalerControllerNoScale(t *testing.T) {
 
 	metricsCallbackMock := metricsmocks.NewMockMetricsClient(mockCtrl)
 	metricsCallbackMock.EXPECT().GetMetric(gomock.Any(), t.Name(), t.Name(), "testmetric", gomock.Any()).Return(
-		[]int64{1.0},
+		[]float64{1.0},
 		time.Now(),
 		nil).AnyTimes()
-	metricsGetter := metrics.NewClient(storageClient, metricsCallbackMock)
+	metricsGetter := metrics.NewClient(storageClient, metricsCallbackMock, nil)
 
 	resyncPeriod := time.Second
 	downscaleStabilisationWindow := 100 * time.Millisecond
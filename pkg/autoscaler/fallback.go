@@ -0,0 +1,170 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/scale"
+	"k9s-autoscaler/pkg/storage"
+	storagetypes "k9s-autoscaler/pkg/storage/types"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	scaleclient "k8s.io/client-go/scale"
+	"k8s.io/klog/v2"
+	metricsclient "k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
+)
+
+const (
+	fallbackActiveReason   = "MetricsUnavailable"
+	fallbackInactiveReason = "MetricsAvailable"
+)
+
+// Interface to storage required by fallback.
+type fallbackStorage interface {
+	storagetypes.AutoscalerGetter
+	storagetypes.AutoscalerConditionSetter
+}
+
+// A metrics client adapter that implements fallback scale for autoscalers
+// with fallback spec. It counts consecutive failures of each autoscaler
+// metric. Once any metric reaches the failure threshold, the target is scaled
+// to fallback replicas and all metrics of the autoscaler fail, such that the
+// HPA does not act on partial metrics. Fallback ends once failed metrics
+// recover.
+type fallbackMetricsClient struct {
+	metricsclient.MetricsClient
+
+	storage         fallbackStorage
+	scaleNamespacer scaleclient.ScalesGetter
+
+	// protects fields below.
+	lock   sync.Mutex
+	states map[string]*fallbackState
+}
+
+// Fallback state of an autoscaler.
+type fallbackState struct {
+	// consecutive failures by metric name.
+	failures map[string]int
+	active   bool
+}
+
+// Create a new fallback adapter of client that uses scaleNamespacer to scale
+// targets to fallback replicas.
+func newFallbackMetricsClient(storage fallbackStorage, scaleNamespacer scaleclient.ScalesGetter, client metricsclient.MetricsClient) metricsclient.MetricsClient {
+	return &fallbackMetricsClient{
+		MetricsClient:   client,
+		storage:         storage,
+		scaleNamespacer: scaleNamespacer,
+		states:          make(map[string]*fallbackState),
+	}
+}
+
+func (c *fallbackMetricsClient) GetExternalMetric(metricName string, namespace string, selector labels.Selector) ([]int64, time.Time, error) {
+	values, ts, err := c.MetricsClient.GetExternalMetric(metricName, namespace, selector)
+
+	autoscalerName := storage.DecodeMetricHPA(selector)
+	key := namespace + "/" + autoscalerName
+	as, getErr := c.storage.Get(autoscalerName, namespace)
+	if getErr != nil || as.Spec.Fallback == nil {
+		c.lock.Lock()
+		state, ok := c.states[key]
+		delete(c.states, key)
+		c.lock.Unlock()
+		if ok && state.active {
+			klog.InfoS("fallback ended", "autoscaler", autoscalerName, "namespace", namespace, "reason", "fallback removed")
+			c.setInactiveCondition(autoscalerName, namespace, "fallback is not configured")
+		}
+		return values, ts, err
+	}
+	fallback := as.Spec.Fallback
+
+	c.lock.Lock()
+	state, ok := c.states[key]
+	if !ok {
+		state = &fallbackState{failures: make(map[string]int)}
+		c.states[key] = state
+	}
+	if err != nil {
+		state.failures[metricName]++
+	} else {
+		delete(state.failures, metricName)
+	}
+	failedMetric, failedCount := "", 0
+	for name, failures := range state.failures {
+		if failures >= int(fallback.FailureThreshold) {
+			failedMetric, failedCount = name, failures
+			break
+		}
+	}
+	wasActive := state.active
+	active := len(failedMetric) > 0
+	state.active = active
+	c.lock.Unlock()
+
+	if !active {
+		if wasActive {
+			klog.InfoS("fallback ended", "autoscaler", autoscalerName, "namespace", namespace)
+			c.setInactiveCondition(autoscalerName, namespace, "metrics are available")
+		}
+		return values, ts, err
+	}
+
+	message := fmt.Sprintf("metric %s failed %d consecutive times, using fallback replicas %d", failedMetric, failedCount, fallback.Replicas)
+	if !wasActive {
+		klog.InfoS("fallback started", "autoscaler", autoscalerName, "namespace", namespace, "metric", failedMetric, "replicas", fallback.Replicas)
+		c.setCondition(autoscalerName, namespace, &prototypes.Condition{
+			Type:    prototypes.Condition_FallbackActive,
+			Status:  string(v1.ConditionTrue),
+			Reason:  fallbackActiveReason,
+			Message: message,
+		})
+	}
+	if err := c.scaleToFallback(autoscalerName, namespace, fallback.Replicas); err != nil {
+		klog.InfoS("failed to scale to fallback replicas", "autoscaler", autoscalerName, "namespace", namespace, "error", err)
+	}
+
+	return nil, time.Time{}, fmt.Errorf("fallback active: %s", message)
+}
+
+// Scales target of autoscaler to replicas if not already.
+func (c *fallbackMetricsClient) scaleToFallback(autoscalerName, namespace string, replicas int32) error {
+	scaler := c.scaleNamespacer.Scales(namespace)
+	resource := schema.GroupResource{Group: scale.ScalingResourceGroup}
+	current, err := scaler.Get(context.TODO(), resource, autoscalerName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if current.Spec.Replicas == replicas {
+		return nil
+	}
+
+	klog.InfoS("scaling to fallback replicas", "autoscaler", autoscalerName, "namespace", namespace, "current", current.Spec.Replicas, "replicas", replicas)
+	current.Spec.Replicas = replicas
+	_, err = scaler.Update(context.TODO(), resource, current, metav1.UpdateOptions{})
+
+	return err
+}
+
+func (c *fallbackMetricsClient) setCondition(autoscalerName, namespace string, condition *prototypes.Condition) {
+	if err := c.storage.SetCondition(autoscalerName, namespace, condition); err != nil {
+		klog.InfoS("failed to set fallback condition", "autoscaler", autoscalerName, "namespace", namespace, "error", err)
+	}
+}
+
+func (c *fallbackMetricsClient) setInactiveCondition(autoscalerName, namespace, message string) {
+	c.setCondition(autoscalerName, namespace, &prototypes.Condition{
+		Type:    prototypes.Condition_FallbackActive,
+		Status:  string(v1.ConditionFalse),
+		Reason:  fallbackInactiveReason,
+		Message: message,
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/scale"
+	scalemocks "k9s-autoscaler/pkg/scale/mocks"
+	"k9s-autoscaler/pkg/storage"
+	storagemocks "k9s-autoscaler/pkg/storage/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+	metricsclient "k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
+)
+
+// A metrics client that fails metrics in failed.
+type testFailingMetricsClient struct {
+	metricsclient.MetricsClient
+	failed map[string]bool
+}
+
+func (c *testFailingMetricsClient) GetExternalMetric(metricName string, namespace string, selector labels.Selector) ([]int64, time.Time, error) {
+	if c.failed[metricName] {
+		return nil, time.Time{}, fmt.Errorf("test error")
+	}
+	return []int64{1000}, time.Now(), nil
+}
+
+func TestFallbackMetricsClient(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := storagemocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+	err = storageClient.Add(&prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testns",
+		Spec: &prototypes.AutoscalerSpec{
+			Min: 1,
+			Max: 10,
+			Metrics: []*prototypes.Metric{
+				{Name: "metric1", Target: 1},
+				{Name: "metric2", Target: 1},
+			},
+			Fallback: &prototypes.Fallback{FailureThreshold: 2, Replicas: 5},
+		},
+	})
+	require.NoError(t, err)
+
+	scalerMock := scalemocks.NewMockScalingClient(mockCtrl)
+	current := int32(1)
+	scalerMock.EXPECT().GetScale(gomock.Any(), "testas", "testns", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name, namespace string, target *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
+			return &prototypes.Scale{
+				Spec:   &prototypes.ScaleSpec{Desired: current},
+				Status: &prototypes.ScaleStatus{Current: current},
+			}, nil
+		}).AnyTimes()
+
+	metricsClient := &testFailingMetricsClient{failed: map[string]bool{"metric1": true}}
+	client := newFallbackMetricsClient(storageClient, scale.NewGetter(storageClient, scalerMock), metricsClient)
+	selector := labels.SelectorFromSet(labels.Set{"hpa": "testas"})
+	fallbackCondition := func() *prototypes.Condition {
+		status, err := storageClient.GetStatus("testas", "testns")
+		require.NoError(t, err)
+		for _, condition := range status.Conditions {
+			if condition.Type == prototypes.Condition_FallbackActive {
+				return condition
+			}
+		}
+		return nil
+	}
+
+	// below threshold
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+	values, _, err := client.GetExternalMetric("metric2", "testns", selector)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1000}, values)
+	require.Nil(t, fallbackCondition())
+
+	// threshold reached scales to fallback replicas and fails all metrics
+	scalerMock.EXPECT().SetScaleTarget(gomock.Any(), "testas", "testns", gomock.Any(), &prototypes.ScaleSpec{Desired: 5}).DoAndReturn(
+		func(ctx context.Context, name, namespace string, target *prototypes.AutoscalerTarget, spec *prototypes.ScaleSpec) error {
+			current = spec.Desired
+			return nil
+		})
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+	_, _, err = client.GetExternalMetric("metric2", "testns", selector)
+	require.Error(t, err)
+	condition := fallbackCondition()
+	require.NotNil(t, condition)
+	require.Equal(t, "True", condition.Status)
+	require.Equal(t, fallbackActiveReason, condition.Reason)
+
+	// recovery
+	metricsClient.failed["metric1"] = false
+	values, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1000}, values)
+	condition = fallbackCondition()
+	require.NotNil(t, condition)
+	require.Equal(t, "False", condition.Status)
+	require.Equal(t, fallbackInactiveReason, condition.Reason)
+
+	// removing fallback spec during an outage clears the condition
+	metricsClient.failed["metric1"] = true
+	for i := 0; i < 2; i++ {
+		_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+		require.Error(t, err)
+	}
+	require.Equal(t, "True", fallbackCondition().Status)
+	as, err := storageClient.Get("testas", "testns")
+	require.NoError(t, err)
+	as.Spec.Fallback = nil
+	err = storageClient.Update(as)
+	require.NoError(t, err)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.EqualError(t, err, "test error")
+	condition = fallbackCondition()
+	require.NotNil(t, condition)
+	require.Equal(t, "False", condition.Status)
+	require.Equal(t, fallbackInactiveReason, condition.Reason)
+}
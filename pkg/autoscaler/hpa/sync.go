@@ -0,0 +1,40 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package hpa
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/controller"
+)
+
+// Reconciles all autoscalers once, ordered by namespace and name. Used
+// instead of Run() to drive the controller explicitly, for example when its
+// clock is fake. Returns errors of all failed reconciles.
+func (a *HorizontalController) Sync(ctx context.Context) error {
+	hpas, err := a.hpaLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	keys := []string{}
+	for _, hpa := range hpas {
+		key, err := controller.KeyFunc(hpa)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	errs := []error{}
+	for _, key := range keys {
+		if _, err := a.reconcileKey(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -8,4 +8,7 @@ import "context"
 type Controller interface {
 	// Start the controller with workers. Stop of ctx is cancelled.
 	Run(ctx context.Context, workers int)
+	// Reconcile all autoscalers once. Used to drive the controller
+	// explicitly instead of Run().
+	Sync(ctx context.Context) error
 }
@@ -0,0 +1,227 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k9s-autoscaler/pkg/autoscaler"
+	"k9s-autoscaler/pkg/events"
+	"k9s-autoscaler/pkg/metrics"
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/scale"
+	scalingtypes "k9s-autoscaler/pkg/scale/types"
+	"k9s-autoscaler/pkg/storage"
+	"k9s-autoscaler/pkg/timeseries"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var (
+	_ metricstypes.MetricsClient = &replayMetricsClient{}
+	_ scalingtypes.ScalingClient = &recordingScalingClient{}
+)
+
+// Backtest options.
+type Options struct {
+	// Autoscaler to backtest.
+	Autoscaler *prototypes.Autoscaler
+	// Recorded time series of each autoscaler metric, by metric name.
+	Metrics map[string][]timeseries.Point
+	// Linearly interpolate metric values between recorded points. Otherwise
+	// each point holds until the next.
+	Linear bool
+	// HPA resync period, downscale stabilization window and tolerance, as in
+	// controller configs.
+	ResyncPeriod                 time.Duration
+	DownscaleStabilizationWindow time.Duration
+	Tolerance                    float64
+	// Interval of timeline entries. Defaults to ResyncPeriod.
+	Step time.Duration
+	// Scale at the start of the backtest. Defaults to autoscaler min.
+	InitialScale int32
+}
+
+// State of a backtest at a point in time.
+type TimelineEntry struct {
+	// Time since start of the backtest.
+	Time time.Duration
+	// Metric values by metric name.
+	Metrics map[string]float64
+	// Desired scale as last calculated by the HPA.
+	DesiredScale int32
+	// Scale applied to the target.
+	AppliedScale int32
+}
+
+// Replays recorded metric values by backtest clock time.
+type replayMetricsClient struct {
+	clock   clock.PassiveClock
+	start   time.Time
+	metrics map[string][]timeseries.Point
+	linear  bool
+}
+
+// Records scale set by the HPA.
+type recordingScalingClient struct {
+	lock  sync.Mutex
+	scale int32
+}
+
+// A no-op status update handler.
+type statusUpdateHandler struct{}
+
+// Runs a backtest of opts.Autoscaler using the HPA controller that
+// autoscaler.NewController builds. The HPA runs on a fake clock that is
+// stepped through the recorded metrics, and is synced every
+// opts.ResyncPeriod, such that backtests do not take wall time. Returns a
+// timeline of metric values and scale sampled every opts.Step until the end
+// of the longest metric series.
+func Run(ctx context.Context, opts Options) ([]*TimelineEntry, error) {
+	if opts.Autoscaler == nil || opts.Autoscaler.Spec == nil {
+		return nil, fmt.Errorf("autoscaler must be specified")
+	}
+	if opts.ResyncPeriod <= 0 {
+		return nil, fmt.Errorf("resync period must be > 0")
+	}
+	step := opts.Step
+	if step == 0 {
+		step = opts.ResyncPeriod
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be > 0")
+	}
+	duration := time.Duration(0)
+	metricNames := []string{}
+	for _, metric := range opts.Autoscaler.Spec.Metrics {
+		points, ok := opts.Metrics[metric.Name]
+		if !ok || len(points) == 0 {
+			return nil, fmt.Errorf("no recorded time series for metric %s", metric.Name)
+		}
+		if last := points[len(points)-1].Offset; last > duration {
+			duration = last
+		}
+		metricNames = append(metricNames, metric.Name)
+	}
+	sort.Strings(metricNames)
+
+	as := proto.Clone(opts.Autoscaler).(*prototypes.Autoscaler)
+	// all metrics are served by the replay client, which needs no configs.
+	metricConfig, err := anypb.New(&emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	for _, metric := range as.Spec.Metrics {
+		metric.Config = metricConfig
+	}
+
+	storageClient := storage.NewClientWithoutMetrics(&statusUpdateHandler{})
+	if err := storageClient.Add(as); err != nil {
+		return nil, err
+	}
+	initialScale := opts.InitialScale
+	if initialScale == 0 {
+		initialScale = as.Spec.Min
+	}
+	scalingClient := &recordingScalingClient{scale: initialScale}
+	start := time.Now()
+	fakeClock := testingclock.NewFakeClock(start)
+	metricsClient := &replayMetricsClient{
+		clock:   fakeClock,
+		start:   start,
+		metrics: opts.Metrics,
+		linear:  opts.Linear,
+	}
+
+	controller := autoscaler.NewControllerWithClock(
+		storageClient,
+		events.NewGetter(nil),
+		scale.NewGetter(storageClient, scalingClient),
+		metrics.NewClientWithClock(storageClient, metricsClient, nil, fakeClock),
+		opts.ResyncPeriod,
+		opts.DownscaleStabilizationWindow,
+		opts.Tolerance,
+		fakeClock)
+
+	klog.InfoS("starting backtest", "autoscaler", as.Name, "namespace", as.Namespace, "duration", duration)
+	// like the HPA controller, the first sync is one resync period after
+	// start. Entries that coincide with a sync are sampled after it.
+	timeline := []*TimelineEntry{}
+	nextSync := opts.ResyncPeriod
+	for offset := time.Duration(0); offset <= duration; offset += step {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for ; nextSync <= offset; nextSync += opts.ResyncPeriod {
+			fakeClock.SetTime(start.Add(nextSync))
+			if err := controller.Sync(ctx); err != nil {
+				klog.ErrorS(err, "backtest sync failed", "autoscaler", as.Name, "namespace", as.Namespace, "time", nextSync)
+			}
+		}
+		fakeClock.SetTime(start.Add(offset))
+
+		entry := &TimelineEntry{
+			Time:         offset,
+			Metrics:      make(map[string]float64),
+			AppliedScale: scalingClient.get(),
+		}
+		for _, name := range metricNames {
+			entry.Metrics[name] = timeseries.ValueAt(opts.Metrics[name], offset, opts.Linear)
+		}
+		if status, err := storageClient.GetStatus(as.Name, as.Namespace); err == nil {
+			entry.DesiredScale = status.DesiredScale
+		}
+		timeline = append(timeline, entry)
+	}
+	klog.InfoS("backtest completed", "autoscaler", as.Name, "namespace", as.Namespace, "entries", len(timeline))
+
+	return timeline, nil
+}
+
+func (c *replayMetricsClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	points, ok := c.metrics[metricName]
+	if !ok || len(points) == 0 {
+		return nil, time.Time{}, fmt.Errorf("no recorded time series for metric %s", metricName)
+	}
+	now := c.clock.Now()
+
+	return []float64{timeseries.ValueAt(points, now.Sub(c.start), c.linear)}, now, nil
+}
+
+func (c *recordingScalingClient) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.scale = target.Desired
+
+	return nil
+}
+
+func (c *recordingScalingClient) GetScale(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
+	scale := c.get()
+
+	return &prototypes.Scale{
+		Spec:   &prototypes.ScaleSpec{Desired: scale},
+		Status: &prototypes.ScaleStatus{Current: scale},
+	}, nil
+}
+
+func (c *recordingScalingClient) get() int32 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.scale
+}
+
+func (h *statusUpdateHandler) AutoscalerStatusUpdated(autoscaler *prototypes.Autoscaler) {
+}
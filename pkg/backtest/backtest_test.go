@@ -0,0 +1,149 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package backtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/timeseries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAutoscaler(name string) *prototypes.Autoscaler {
+	return &prototypes.Autoscaler{
+		Name:      name,
+		Namespace: name,
+		Spec: &prototypes.AutoscalerSpec{
+			Min: 1,
+			Max: 10,
+			Metrics: []*prototypes.Metric{
+				{
+					Name:       "load",
+					Target:     1.0,
+					TargetType: prototypes.Metric_AverageValue,
+				},
+			},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	opts := Options{
+		Autoscaler: newTestAutoscaler(t.Name()),
+		Metrics: map[string][]timeseries.Point{
+			"load": {
+				{Offset: 0, Value: 4},
+				{Offset: 3 * time.Minute, Value: 1},
+				{Offset: 6 * time.Minute, Value: 1},
+			},
+		},
+		ResyncPeriod:                 time.Minute,
+		DownscaleStabilizationWindow: time.Minute,
+		Tolerance:                    0.1,
+	}
+
+	timeline, err := Run(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, timeline, 7)
+	// first sync is after one resync period, and scale down waits for the
+	// stabilization window.
+	for i, expected := range []struct {
+		load           float64
+		desired, scale int32
+	}{
+		{4, 0, 1}, {4, 4, 4}, {4, 4, 4}, {1, 4, 4}, {1, 1, 1}, {1, 1, 1}, {1, 1, 1},
+	} {
+		require.Equal(t, time.Duration(i)*time.Minute, timeline[i].Time)
+		require.Equal(t, expected.load, timeline[i].Metrics["load"], i)
+		require.Equal(t, expected.desired, timeline[i].DesiredScale, i)
+		require.Equal(t, expected.scale, timeline[i].AppliedScale, i)
+	}
+}
+
+func TestRunBehavior(t *testing.T) {
+	// behavior seconds need not be multiples of the resync period.
+	upSeconds, downSeconds := int32(0), int32(150)
+	as := newTestAutoscaler(t.Name())
+	as.Spec.Behavior = &prototypes.Behavior{
+		ScaleUp: &prototypes.ScalingRules{
+			StabilizationWindowSeconds: &upSeconds,
+			Policies:                   []*prototypes.ScalingPolicy{{Value: 2, PeriodSeconds: 45}},
+		},
+		ScaleDown: &prototypes.ScalingRules{
+			StabilizationWindowSeconds: &downSeconds,
+			Policies:                   []*prototypes.ScalingPolicy{{ValueType: prototypes.ScalingPolicy_Percent, Value: 100, PeriodSeconds: 15}},
+		},
+	}
+	opts := Options{
+		Autoscaler: as,
+		Metrics: map[string][]timeseries.Point{
+			"load": {
+				{Offset: 0, Value: 4},
+				{Offset: 3 * time.Minute, Value: 1},
+				{Offset: 6 * time.Minute, Value: 1},
+			},
+		},
+		ResyncPeriod:                 time.Minute,
+		DownscaleStabilizationWindow: time.Minute,
+		Tolerance:                    0.1,
+		Step:                         30 * time.Second,
+	}
+
+	timeline, err := Run(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, timeline, 13)
+	scales := []int32{}
+	for _, entry := range timeline {
+		scales = append(scales, entry.AppliedScale)
+	}
+	// scale up is limited to 2 per 45s, and the window of 150s keeps the 4
+	// recommended until 2m, up to the sync at 5m.
+	require.Equal(t, []int32{1, 1, 3, 3, 4, 4, 4, 4, 4, 4, 1, 1, 1}, scales)
+}
+
+func TestRunInvalid(t *testing.T) {
+	metrics := map[string][]timeseries.Point{"load": {{Offset: 0, Value: 1}}}
+
+	for _, opts := range []Options{
+		{Metrics: metrics, ResyncPeriod: time.Minute},
+		{Autoscaler: newTestAutoscaler(t.Name()), ResyncPeriod: time.Minute},
+		{Autoscaler: newTestAutoscaler(t.Name()), Metrics: metrics},
+		{Autoscaler: newTestAutoscaler(t.Name()), Metrics: metrics, ResyncPeriod: time.Minute, Step: -time.Second},
+	} {
+		_, err := Run(context.Background(), opts)
+		require.Error(t, err)
+	}
+}
+
+func TestWriteTimeline(t *testing.T) {
+	timeline := []*TimelineEntry{
+		{Time: 0, Metrics: map[string]float64{"b": 2, "a": 1.5}, DesiredScale: 2, AppliedScale: 1},
+		{Time: 15 * time.Second, Metrics: map[string]float64{"b": 3, "a": 0}, DesiredScale: 3, AppliedScale: 2},
+	}
+
+	csv := &bytes.Buffer{}
+	require.NoError(t, WriteCSV(csv, timeline))
+	require.Equal(t, strings.Join([]string{
+		"time,a,b,desiredScale,appliedScale",
+		"0,1.5,2,2,1",
+		"15,0,3,3,2",
+		"",
+	}, "\n"), csv.String())
+
+	out := &bytes.Buffer{}
+	require.NoError(t, WriteJSON(out, timeline))
+	entries := []map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+	require.Len(t, entries, 2)
+	require.Equal(t, 15.0, entries[1]["time"])
+	require.Equal(t, map[string]interface{}{"a": 0.0, "b": 3.0}, entries[1]["metrics"])
+	require.Equal(t, 3.0, entries[1]["desiredScale"])
+	require.Equal(t, 2.0, entries[1]["appliedScale"])
+}
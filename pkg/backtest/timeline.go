@@ -0,0 +1,70 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package backtest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// JSON form of a timeline entry. Time is in seconds.
+type timelineEntryJSON struct {
+	Time         float64            `json:"time"`
+	Metrics      map[string]float64 `json:"metrics"`
+	DesiredScale int32              `json:"desiredScale"`
+	AppliedScale int32              `json:"appliedScale"`
+}
+
+// Writes timeline as CSV with a header row. Columns are time in seconds,
+// metric values sorted by name, desired scale and applied scale.
+func WriteCSV(w io.Writer, timeline []*TimelineEntry) error {
+	metricNames := []string{}
+	if len(timeline) > 0 {
+		for name := range timeline[0].Metrics {
+			metricNames = append(metricNames, name)
+		}
+		sort.Strings(metricNames)
+	}
+
+	writer := csv.NewWriter(w)
+	header := append([]string{"time"}, metricNames...)
+	header = append(header, "desiredScale", "appliedScale")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, entry := range timeline {
+		record := []string{strconv.FormatFloat(entry.Time.Seconds(), 'f', -1, 64)}
+		for _, name := range metricNames {
+			record = append(record, strconv.FormatFloat(entry.Metrics[name], 'f', -1, 64))
+		}
+		record = append(record,
+			strconv.FormatInt(int64(entry.DesiredScale), 10),
+			strconv.FormatInt(int64(entry.AppliedScale), 10))
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// Writes timeline as a JSON array. Time is in seconds.
+func WriteJSON(w io.Writer, timeline []*TimelineEntry) error {
+	entries := make([]*timelineEntryJSON, len(timeline))
+	for i, entry := range timeline {
+		entries[i] = &timelineEntryJSON{
+			Time:         entry.Time.Seconds(),
+			Metrics:      entry.Metrics,
+			DesiredScale: entry.DesiredScale,
+			AppliedScale: entry.AppliedScale,
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"k9s-autoscaler/pkg/backtest"
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/timeseries"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+// Struct of required options to run a backtest.
+type BacktestOptions struct {
+	// Path to autoscaler definition in yaml format.
+	// see: pkg/proto/autoscaler.proto
+	AutoscalerPath string
+	// Paths to recorded metric time series by metric name. Files are CSV or
+	// JSON with "time" and "value" fields.
+	// see: pkg/timeseries
+	MetricPaths map[string]string
+	// Linearly interpolate metric values between recorded points.
+	Linear bool
+	// Path of timeline output. Stdout if empty.
+	OutputPath string
+	// Timeline output format, csv or json.
+	Format string
+	// Controller configs, same as ControllerConfig.
+	ResyncPeriod                 time.Duration
+	DownscaleStabilizationWindow time.Duration
+	Tolerance                    float64
+	// Timeline interval. Defaults to ResyncPeriod.
+	Step time.Duration
+	// Scale at the start of the backtest. Defaults to autoscaler min.
+	InitialScale int32
+}
+
+// Creates new BacktestOptions initialized with defaults.
+func NewBacktestOptions() BacktestOptions {
+	return BacktestOptions{
+		MetricPaths:                  make(map[string]string),
+		Format:                       "csv",
+		ResyncPeriod:                 15 * time.Second,
+		DownscaleStabilizationWindow: 5 * time.Minute,
+		Tolerance:                    0.1,
+	}
+}
+
+// Runs a backtest with opts and writes its timeline to opts.OutputPath.
+func RunBacktest(ctx context.Context, opts BacktestOptions) error {
+	if len(opts.AutoscalerPath) == 0 {
+		return fmt.Errorf("autoscaler path must be specified")
+	}
+	var write func(io.Writer, []*backtest.TimelineEntry) error
+	switch opts.Format {
+	case "csv":
+		write = backtest.WriteCSV
+	case "json":
+		write = backtest.WriteJSON
+	default:
+		return fmt.Errorf("unknown output format %s, expected csv or json", opts.Format)
+	}
+
+	bytes, err := os.ReadFile(opts.AutoscalerPath)
+	if err != nil {
+		return err
+	}
+	jsonBytes, err := yaml.YAMLToJSON(bytes)
+	if err != nil {
+		return err
+	}
+	autoscaler := &prototypes.Autoscaler{}
+	if err := protojson.Unmarshal(jsonBytes, autoscaler); err != nil {
+		return fmt.Errorf("failed to process autoscaler: %v", err)
+	}
+	metrics := make(map[string][]timeseries.Point)
+	for name, path := range opts.MetricPaths {
+		points, err := timeseries.Read(path, "value")
+		if err != nil {
+			return err
+		}
+		metrics[name] = points
+	}
+
+	timeline, err := backtest.Run(ctx, backtest.Options{
+		Autoscaler:                   autoscaler,
+		Metrics:                      metrics,
+		Linear:                       opts.Linear,
+		ResyncPeriod:                 opts.ResyncPeriod,
+		DownscaleStabilizationWindow: opts.DownscaleStabilizationWindow,
+		Tolerance:                    opts.Tolerance,
+		Step:                         opts.Step,
+		InitialScale:                 opts.InitialScale,
+	})
+	if err != nil {
+		return err
+	}
+
+	var output io.Writer = os.Stdout
+	if len(opts.OutputPath) > 0 {
+		file, err := os.Create(opts.OutputPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		output = file
+	}
+
+	return write(output, timeline)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBacktest(t *testing.T) {
+	autoscalerYAML := `
+name: testauto1
+namespace: testnamespace
+spec:
+  min: 1
+  max: 10
+  metrics:
+  - name: testmetric
+    target: 10
+    targetType: AverageValue
+`
+	dir := t.TempDir()
+	autoscalerPath := filepath.Join(dir, "autoscaler.yaml")
+	require.NoError(t, os.WriteFile(autoscalerPath, []byte(autoscalerYAML), 0600))
+	metricPath := filepath.Join(dir, "testmetric.csv")
+	require.NoError(t, os.WriteFile(metricPath, []byte("time,value\n0,30\n180,30\n"), 0600))
+
+	opts := NewBacktestOptions()
+	opts.AutoscalerPath = autoscalerPath
+	opts.MetricPaths["testmetric"] = metricPath
+	opts.OutputPath = filepath.Join(dir, "timeline.csv")
+	opts.ResyncPeriod = time.Minute
+	require.NoError(t, RunBacktest(context.Background(), opts))
+
+	file, err := os.Open(opts.OutputPath)
+	require.NoError(t, err)
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	// first HPA sync is after one resync period
+	require.Len(t, records, 5)
+	require.Equal(t, []string{"time", "testmetric", "desiredScale", "appliedScale"}, records[0])
+	require.Equal(t, []string{"0", "30", "0", "1"}, records[1])
+	require.Equal(t, []string{"60", "30", "3", "3"}, records[2])
+	require.Equal(t, []string{"180", "30", "3", "3"}, records[4])
+
+	opts.Format = "xml"
+	require.Error(t, RunBacktest(context.Background(), opts))
+	opts.Format = "json"
+	opts.MetricPaths["testmetric"] = filepath.Join(dir, "missing.csv")
+	require.Error(t, RunBacktest(context.Background(), opts))
+}
@@ -4,6 +4,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -13,9 +14,14 @@ import (
 	configproto "k9s-autoscaler/pkg/cmd/proto"
 	"k9s-autoscaler/pkg/events"
 	eventstypes "k9s-autoscaler/pkg/events/types"
+	grpcserver "k9s-autoscaler/pkg/grpc/server"
+	"k9s-autoscaler/pkg/http/server"
 	"k9s-autoscaler/pkg/metrics"
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
 	"k9s-autoscaler/pkg/providers"
+	providersproto "k9s-autoscaler/pkg/providers/proto"
 	"k9s-autoscaler/pkg/scale"
+	"k9s-autoscaler/pkg/storage"
 
 	_ "k9s-autoscaler/pkg/providers/events"
 	_ "k9s-autoscaler/pkg/providers/metrics"
@@ -30,9 +36,12 @@ import (
 // Define a core autoscaler controller command abstraction that can be used
 // to construct concrete instances. For example using a CLI.
 type ControllerCMD struct {
-	opts       Options
-	controller autoscalertypes.Controller
-	cancel     context.CancelFunc
+	opts          Options
+	controller    autoscalertypes.Controller
+	storageClient *storage.Client
+	apiServer     *server.Server
+	grpcServer    *grpcserver.Server
+	cancel        context.CancelFunc
 }
 
 // Creates a new instanec with opts. Returned controller command must be started
@@ -60,20 +69,42 @@ func NewControllerCMD(opts Options) (*ControllerCMD, error) {
 		return nil, fmt.Errorf("failed to process config: %v", err)
 	}
 
-	controller, err := NewControllerFromConfigs(&configs)
+	controller, storageClient, err := NewControllerFromConfigs(&configs)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ControllerCMD{
-		opts:       opts,
-		controller: controller,
-	}, nil
+	c := &ControllerCMD{
+		opts:          opts,
+		controller:    controller,
+		storageClient: storageClient,
+	}
+	if len(opts.APIListenAddress) > 0 {
+		c.apiServer = server.NewServer(opts.APIListenAddress, storageClient)
+	}
+	if len(opts.GRPCListenAddress) > 0 {
+		c.grpcServer = grpcserver.NewServer(opts.GRPCListenAddress, storageClient)
+	}
+
+	return c, nil
 }
 
+// Starts the API servers, if configured, then runs the controller. Blocks until
+// Stop() is called.
 func (c *ControllerCMD) Start() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	c.cancel = cancel
+
+	if c.apiServer != nil {
+		if err := c.apiServer.Start(); err != nil {
+			return err
+		}
+	}
+	if c.grpcServer != nil {
+		if err := c.grpcServer.Start(); err != nil {
+			return err
+		}
+	}
 	c.controller.Run(ctx, c.opts.Workers)
 
 	return nil
@@ -81,52 +112,100 @@ func (c *ControllerCMD) Start() error {
 
 func (c *ControllerCMD) Stop() error {
 	c.cancel()
+	defer c.storageClient.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var errs []error
+	if c.apiServer != nil {
+		if err := c.apiServer.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.grpcServer != nil {
+		if err := c.grpcServer.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // Utility function that creates a new autoscaler controller from given configuration
 // proto message. It handles all the required validation and initialization of
-// provider adapters.
-func NewControllerFromConfigs(configs *configproto.ControllerConfig) (autoscalertypes.Controller, error) {
+// provider adapters. Returns the controller and its storage client.
+func NewControllerFromConfigs(configs *configproto.ControllerConfig) (autoscalertypes.Controller, *storage.Client, error) {
 	if configs.StorageClient == nil {
-		return nil, fmt.Errorf("no storage client specified")
+		return nil, nil, fmt.Errorf("no storage client specified")
 	}
-	if configs.MetricsClient == nil {
-		return nil, fmt.Errorf("no metrics client specified")
+	metricsClientConfigs, err := mergeProviderConfigs("metrics", configs.MetricsClient, configs.MetricsClients)
+	if err != nil {
+		return nil, nil, err
 	}
-	if configs.ScalingClient == nil {
-		return nil, fmt.Errorf("no scaling client specified")
+	scalingClientConfigs, err := mergeProviderConfigs("scaling", configs.ScalingClient, configs.ScalingClients)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	storageClient, err := providers.StorageClient(configs.StorageClient)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create storage client: %v", err)
+		return nil, nil, fmt.Errorf("failed to create storage client: %v", err)
 	}
-	metricsClient, err := providers.MetricsClient(configs.MetricsClient)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create metrics client: %v", err)
+	for _, config := range metricsClientConfigs {
+		if _, err := providers.MetricsClient(config); err != nil {
+			return nil, nil, fmt.Errorf("failed to create metrics client %s: %v", config.Config.TypeUrl, err)
+		}
 	}
-	scalingClient, err := providers.ScalingClient(configs.ScalingClient)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create scaling client: %v", err)
+	for _, config := range scalingClientConfigs {
+		if _, err := providers.ScalingClient(config); err != nil {
+			return nil, nil, fmt.Errorf("failed to create scaling client %s: %v", config.Config.TypeUrl, err)
+		}
 	}
 	var eventsCreator eventstypes.EventCreator
 	if configs.EventsClient != nil {
 		eventsCreator, err = providers.EventsClient(configs.EventsClient)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create events client: %v", err)
+			return nil, nil, fmt.Errorf("failed to create events client: %v", err)
 		}
 	}
 
+	var metricsClient metricstypes.MetricsClient = providers.NewMetricsRouter()
+	if configs.MetricsCacheTtl != nil {
+		metricsClient = metrics.NewCachedClient(metricsClient, configs.MetricsCacheTtl.AsDuration())
+	}
+
 	controller := autoscaler.NewController(
 		storageClient,
 		events.NewGetter(eventsCreator),
-		scale.NewGetter(storageClient, scalingClient),
-		metrics.NewClient(storageClient, metricsClient),
+		scale.NewGetter(storageClient, providers.NewScalingRouter()),
+		metrics.NewClient(storageClient, metricsClient, eventsCreator),
 		configs.ResyncPeriod.AsDuration(),
 		configs.DownscaleStabilizationWindow.AsDuration(),
 		configs.Tolerance)
 
-	return controller, nil
+	return controller, storageClient, nil
+}
+
+// Combines a single provider config with a list of additional ones. At least
+// one config must be given and each provider type may only appear once.
+func mergeProviderConfigs(kind string, config *providersproto.ProviderConfig, configs []*providersproto.ProviderConfig) ([]*providersproto.ProviderConfig, error) {
+	if config != nil {
+		configs = append([]*providersproto.ProviderConfig{config}, configs...)
+	}
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no %s client specified", kind)
+	}
+
+	seen := make(map[string]bool)
+	for _, config := range configs {
+		if config.Config == nil {
+			return nil, fmt.Errorf("%s client config must be specified", kind)
+		}
+		if seen[config.Config.TypeUrl] {
+			return nil, fmt.Errorf("%s client %s configured more than once", kind, config.Config.TypeUrl)
+		}
+		seen[config.Config.TypeUrl] = true
+	}
+
+	return configs, nil
 }
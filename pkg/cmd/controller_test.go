@@ -7,13 +7,16 @@ import (
 	"testing"
 	"time"
 
+	providersproto "k9s-autoscaler/pkg/providers/proto"
+
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestControllerCMD(t *testing.T) {
 	optionsYAML := `
 storageClient:
-  name: inline
   config:
     "@type": type.googleapis.com/k9sautoscaler.providers.storage.proto.InlineStorageConfig
     autoscalers:
@@ -22,13 +25,17 @@ storageClient:
       spec:
         min: 1
         max: 30
+        target:
+          config:
+            "@type": type.googleapis.com/k9sautoscaler.providers.metrics.proto.SimScalingTargetConfig
         metrics:
         - name: testmetric
           target: 70
+          config:
+            "@type": type.googleapis.com/k9sautoscaler.providers.metrics.proto.SimMetricConfig
 metricsClient:
-  name: sim
   config:
-    "@type": type.googleapis.com/k9sautoscaler.providers.metrics.proto.SimMetricsConfig
+    "@type": type.googleapis.com/k9sautoscaler.providers.metrics.proto.SimConfig
     metricName: "testmetric"
     autoscalersConfig:
     - autoscalerName: testauto1
@@ -42,9 +49,11 @@ metricsClient:
       - timespan: 5s
         load: 50 
 scalingClient:
-  name: sim
+  config:
+    "@type": type.googleapis.com/k9sautoscaler.providers.metrics.proto.SimConfig
 eventsClient:
-  name: klog
+  config:
+    "@type": type.googleapis.com/k9sautoscaler.providers.events.proto.KLog
 resyncPeriod: 1s
 `
 	configFile, err := os.CreateTemp("/tmp", t.Name())
@@ -56,9 +65,35 @@ resyncPeriod: 1s
 
 	opts := NewOptions()
 	opts.YAMLConfigPath = configFile.Name()
+	opts.APIListenAddress = "localhost:0"
+	opts.GRPCListenAddress = "localhost:0"
 	c, err := NewControllerCMD(opts)
 	require.NoError(t, err)
 	go c.Start()
 	time.Sleep(15 * time.Second)
 	c.Stop()
 }
+
+func TestMergeProviderConfigs(t *testing.T) {
+	config1, err := anypb.New(&wrapperspb.StringValue{})
+	require.NoError(t, err)
+	config2, err := anypb.New(&wrapperspb.Int32Value{})
+	require.NoError(t, err)
+
+	_, err = mergeProviderConfigs("test", nil, nil)
+	require.Error(t, err)
+
+	configs, err := mergeProviderConfigs("test", &providersproto.ProviderConfig{Config: config1}, nil)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+
+	configs, err = mergeProviderConfigs("test", &providersproto.ProviderConfig{Config: config1}, []*providersproto.ProviderConfig{{Config: config2}})
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	require.Equal(t, config1, configs[0].Config)
+
+	_, err = mergeProviderConfigs("test", &providersproto.ProviderConfig{Config: config1}, []*providersproto.ProviderConfig{{Config: config1}})
+	require.Error(t, err)
+	_, err = mergeProviderConfigs("test", nil, []*providersproto.ProviderConfig{{}})
+	require.Error(t, err)
+}
@@ -11,6 +11,14 @@ type Options struct {
 	YAMLConfigPath string
 	// Number of controller workers, defaults to 1.
 	Workers int
+	// Optional listen address for the autoscaler REST API server. If empty, the
+	// server is not started.
+	// see: pkg/http/openapi/api.yaml
+	APIListenAddress string
+	// Optional listen address for the autoscaler gRPC server. If empty, the
+	// server is not started.
+	// see: pkg/proto/autoscaler_service.proto
+	GRPCListenAddress string
 }
 
 // Creates new Options initialized with defaults.
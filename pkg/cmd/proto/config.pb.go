@@ -39,8 +39,16 @@ type ControllerConfig struct {
 	StorageClient *proto.ProviderConfig `protobuf:"bytes,1,opt,name=storage_client,json=storageClient,proto3" json:"storage_client,omitempty"`
 	// Define the metrics provider adapter configuration.
 	MetricsClient *proto.ProviderConfig `protobuf:"bytes,2,opt,name=metrics_client,json=metricsClient,proto3" json:"metrics_client,omitempty"`
+	// Define additional metrics provider adapter configurations. Together with
+	// metrics_client, each provider type may be configured once. Each metric
+	// is routed to its provider by the type of its config.
+	MetricsClients []*proto.ProviderConfig `protobuf:"bytes,5,rep,name=metrics_clients,json=metricsClients,proto3" json:"metrics_clients,omitempty"`
 	// Define the scaling provider adapter configuration.
 	ScalingClient *proto.ProviderConfig `protobuf:"bytes,3,opt,name=scaling_client,json=scalingClient,proto3" json:"scaling_client,omitempty"`
+	// Define additional scaling provider adapter configurations. Together with
+	// scaling_client, each provider type may be configured once. Each
+	// autoscaler is routed to its provider by the type of its target config.
+	ScalingClients []*proto.ProviderConfig `protobuf:"bytes,6,rep,name=scaling_clients,json=scalingClients,proto3" json:"scaling_clients,omitempty"`
 	// Optional events provider adapter. If not provided, a simple logger is
 	// used.
 	EventsClient *proto.ProviderConfig `protobuf:"bytes,4,opt,name=events_client,json=eventsClient,proto3,oneof" json:"events_client,omitempty"`
@@ -52,6 +60,12 @@ type ControllerConfig struct {
 	// Autoscaler scaling change tolerance.
 	// See: https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/#algorithm-details
 	Tolerance float64 `protobuf:"fixed64,10,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Optional duration to cache metric values. Metrics with the same name and
+	// config share cached values across autoscalers, and concurrent identical
+	// requests are coalesced. Metrics that depend on the autoscaler, such as
+	// those with per-autoscaler placeholders, are cached per autoscaler.
+	// Caching is disabled if not set.
+	MetricsCacheTtl *durationpb.Duration `protobuf:"bytes,11,opt,name=metrics_cache_ttl,json=metricsCacheTtl,proto3,oneof" json:"metrics_cache_ttl,omitempty"`
 }
 
 func (x *ControllerConfig) Reset() {
@@ -100,6 +114,13 @@ func (x *ControllerConfig) GetMetricsClient() *proto.ProviderConfig {
 	return nil
 }
 
+func (x *ControllerConfig) GetMetricsClients() []*proto.ProviderConfig {
+	if x != nil {
+		return x.MetricsClients
+	}
+	return nil
+}
+
 func (x *ControllerConfig) GetScalingClient() *proto.ProviderConfig {
 	if x != nil {
 		return x.ScalingClient
@@ -107,6 +128,13 @@ func (x *ControllerConfig) GetScalingClient() *proto.ProviderConfig {
 	return nil
 }
 
+func (x *ControllerConfig) GetScalingClients() []*proto.ProviderConfig {
+	if x != nil {
+		return x.ScalingClients
+	}
+	return nil
+}
+
 func (x *ControllerConfig) GetEventsClient() *proto.ProviderConfig {
 	if x != nil {
 		return x.EventsClient
@@ -135,6 +163,13 @@ func (x *ControllerConfig) GetTolerance() float64 {
 	return 0
 }
 
+func (x *ControllerConfig) GetMetricsCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MetricsCacheTtl
+	}
+	return nil
+}
+
 var File_config_proto protoreflect.FileDescriptor
 
 var file_config_proto_rawDesc = []byte{
@@ -143,7 +178,7 @@ var file_config_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xbe, 0x04, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x6f, 0x22, 0xd0, 0x06, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
 	0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70,
@@ -155,12 +190,23 @@ var file_config_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x39, 0x73,
-	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e,
+	0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x39,
+	0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x56, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x39, 0x73, 0x61,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e,
 	0x74, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x2d, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
@@ -178,10 +224,17 @@ var file_config_proto_rawDesc = []byte{
 	0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64,
 	0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18,
 	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x42, 0x24, 0x5a, 0x22, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x4a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x74, 0x74, 0x6c, 0x42, 0x24, 0x5a, 0x22, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -205,15 +258,18 @@ var file_config_proto_goTypes = []interface{}{
 var file_config_proto_depIdxs = []int32{
 	1, // 0: ControllerConfig.storage_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
 	1, // 1: ControllerConfig.metrics_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
-	1, // 2: ControllerConfig.scaling_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
-	1, // 3: ControllerConfig.events_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
-	2, // 4: ControllerConfig.resync_period:type_name -> google.protobuf.Duration
-	2, // 5: ControllerConfig.downscale_stabilization_window:type_name -> google.protobuf.Duration
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1, // 2: ControllerConfig.metrics_clients:type_name -> k9sautoscaler.providers.proto.ProviderConfig
+	1, // 3: ControllerConfig.scaling_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
+	1, // 4: ControllerConfig.scaling_clients:type_name -> k9sautoscaler.providers.proto.ProviderConfig
+	1, // 5: ControllerConfig.events_client:type_name -> k9sautoscaler.providers.proto.ProviderConfig
+	2, // 6: ControllerConfig.resync_period:type_name -> google.protobuf.Duration
+	2, // 7: ControllerConfig.downscale_stabilization_window:type_name -> google.protobuf.Duration
+	2, // 8: ControllerConfig.metrics_cache_ttl:type_name -> google.protobuf.Duration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_config_proto_init() }
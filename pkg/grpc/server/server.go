@@ -0,0 +1,228 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	storagetypes "k9s-autoscaler/pkg/storage/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+)
+
+var (
+	_ prototypes.AutoscalerServiceServer = &Server{}
+)
+
+// Storage operations used by the server.
+type AutoscalerClient interface {
+	storagetypes.AutoscalerCRUDder
+	storagetypes.AutoscalerWatcher
+}
+
+// An embedded gRPC server that implements AutoscalerService defined in
+// pkg/proto/autoscaler_service.proto. Calls are mapped onto storage client
+// operations.
+type Server struct {
+	prototypes.UnimplementedAutoscalerServiceServer
+
+	client        AutoscalerClient
+	listenAddress string
+	grpcServer    *grpc.Server
+	stopChan      chan struct{}
+	stopOnce      sync.Once
+}
+
+// Creates a new server that listens on listenAddress and uses client for
+// autoscaler operations. Server must be started by calling Start().
+func NewServer(listenAddress string, client AutoscalerClient) *Server {
+	s := &Server{
+		client:        client,
+		listenAddress: listenAddress,
+		grpcServer:    grpc.NewServer(),
+		stopChan:      make(chan struct{}),
+	}
+	prototypes.RegisterAutoscalerServiceServer(s.grpcServer, s)
+
+	return s
+}
+
+// Starts listening and serving requests in the background. Returns an error
+// if listening fails.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", s.listenAddress, err)
+	}
+
+	go func() {
+		klog.V(1).InfoS("starting grpc server", "listen", listener.Addr().String())
+		err := s.grpcServer.Serve(listener)
+		klog.V(1).InfoS("grpc server terminated", "error", err)
+	}()
+
+	return nil
+}
+
+// Gracefully stops the server. Active status watches are terminated. If ctx
+// is done before pending calls complete, server is forcibly stopped. Stop may
+// be called more than once.
+func (s *Server) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopChan) })
+
+	doneChan := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(doneChan)
+	}()
+	select {
+	case <-doneChan:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+// List defined autoscalers.
+func (s *Server) List(ctx context.Context, req *prototypes.ListAutoscalersRequest) (*prototypes.ListAutoscalersResponse, error) {
+	list, err := s.client.List()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &prototypes.ListAutoscalersResponse{}
+	for _, autoscaler := range list {
+		if len(req.Namespace) == 0 || autoscaler.Namespace == req.Namespace {
+			resp.Autoscalers = append(resp.Autoscalers, autoscaler)
+		}
+	}
+
+	return resp, nil
+}
+
+// Get an autoscaler by name and namespace.
+func (s *Server) Get(ctx context.Context, req *prototypes.GetAutoscalerRequest) (*prototypes.Autoscaler, error) {
+	autoscaler, err := s.client.Get(req.Name, req.Namespace)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return autoscaler, nil
+}
+
+// Create a new autoscaler.
+func (s *Server) Create(ctx context.Context, req *prototypes.CreateAutoscalerRequest) (*prototypes.Autoscaler, error) {
+	if req.Autoscaler == nil {
+		return nil, status.Error(codes.InvalidArgument, "autoscaler is required")
+	}
+	req.Autoscaler.Status = nil
+	if err := s.client.Add(req.Autoscaler); err != nil {
+		return nil, toStatusError(err)
+	}
+	autoscaler, err := s.client.Get(req.Autoscaler.Name, req.Autoscaler.Namespace)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return autoscaler, nil
+}
+
+// Update an existing autoscaler.
+func (s *Server) Update(ctx context.Context, req *prototypes.UpdateAutoscalerRequest) (*prototypes.Autoscaler, error) {
+	if req.Autoscaler == nil {
+		return nil, status.Error(codes.InvalidArgument, "autoscaler is required")
+	}
+	req.Autoscaler.Status = nil
+	if err := s.client.Update(req.Autoscaler); err != nil {
+		return nil, toStatusError(err)
+	}
+	autoscaler, err := s.client.Get(req.Autoscaler.Name, req.Autoscaler.Namespace)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return autoscaler, nil
+}
+
+// Delete an existing autoscaler.
+func (s *Server) Delete(ctx context.Context, req *prototypes.DeleteAutoscalerRequest) (*emptypb.Empty, error) {
+	if err := s.client.Delete(req.Name, req.Namespace); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Stream status of matching autoscalers. If the watch falls behind, stream
+// is terminated with Unavailable and clients should retry.
+func (s *Server) WatchStatus(req *prototypes.WatchStatusRequest, stream prototypes.AutoscalerService_WatchStatusServer) error {
+	snapshot, w, err := s.client.WatchAutoscalers(req.Namespace)
+	if err != nil {
+		return toStatusError(err)
+	}
+	defer w.Stop()
+
+	send := func(autoscaler *prototypes.Autoscaler) error {
+		if len(req.Name) > 0 && autoscaler.Name != req.Name {
+			return nil
+		}
+		return stream.Send(&prototypes.AutoscalerStatusUpdate{
+			Name:      autoscaler.Name,
+			Namespace: autoscaler.Namespace,
+			Status:    autoscaler.Status,
+		})
+	}
+
+	for _, autoscaler := range snapshot {
+		if autoscaler.Status == nil {
+			continue
+		}
+		if err := send(autoscaler); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-s.stopChan:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return status.Error(codes.Unavailable, "watch terminated")
+			}
+			if event.Type != watch.Modified || event.Autoscaler.Status == nil {
+				continue
+			}
+			if err := send(event.Autoscaler); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Maps k8s api errors onto gRPC status errors.
+func toStatusError(err error) error {
+	switch {
+	case errors.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.IsAlreadyExists(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.IsBadRequest(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage"
+	"k9s-autoscaler/pkg/storage/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerCRUD(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+	client, cleanup := newTestClient(t, storageClient)
+	defer cleanup()
+	ctx := context.Background()
+
+	// create
+	autoscaler, err := client.Create(ctx, &prototypes.CreateAutoscalerRequest{Autoscaler: newTestAutoscaler("testas", "testns")})
+	require.NoError(t, err)
+	require.Equal(t, "testas", autoscaler.Name)
+	_, err = client.Create(ctx, &prototypes.CreateAutoscalerRequest{Autoscaler: newTestAutoscaler("testas", "testns")})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = client.Create(ctx, &prototypes.CreateAutoscalerRequest{Autoscaler: &prototypes.Autoscaler{Name: "testas2", Namespace: "testns"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.Create(ctx, &prototypes.CreateAutoscalerRequest{Autoscaler: newTestAutoscaler("testas2", "otherns")})
+	require.NoError(t, err)
+
+	// get
+	autoscaler, err = client.Get(ctx, &prototypes.GetAutoscalerRequest{Name: "testas", Namespace: "testns"})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, autoscaler.Spec.Max)
+	_, err = client.Get(ctx, &prototypes.GetAutoscalerRequest{Name: "none", Namespace: "testns"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// list
+	list, err := client.List(ctx, &prototypes.ListAutoscalersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Autoscalers, 2)
+	list, err = client.List(ctx, &prototypes.ListAutoscalersRequest{Namespace: "testns"})
+	require.NoError(t, err)
+	require.Len(t, list.Autoscalers, 1)
+
+	// update
+	updated := newTestAutoscaler("testas", "testns")
+	updated.Spec.Max = 20
+	autoscaler, err = client.Update(ctx, &prototypes.UpdateAutoscalerRequest{Autoscaler: updated})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, autoscaler.Spec.Max)
+	_, err = client.Update(ctx, &prototypes.UpdateAutoscalerRequest{Autoscaler: newTestAutoscaler("none", "testns")})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.Update(ctx, &prototypes.UpdateAutoscalerRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// delete
+	_, err = client.Delete(ctx, &prototypes.DeleteAutoscalerRequest{Name: "testas", Namespace: "testns"})
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, &prototypes.DeleteAutoscalerRequest{Name: "testas", Namespace: "testns"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServerWatchStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+	client, cleanup := newTestClient(t, storageClient)
+	defer cleanup()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updateStatus := func(name string, replicas int32) {
+		_, err := storageClient.HorizontalPodAutoscalers("testns").UpdateStatus(
+			ctx,
+			&v2.HorizontalPodAutoscaler{
+				ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "testns"},
+				Status:     v2.HorizontalPodAutoscalerStatus{CurrentReplicas: replicas},
+			},
+			v1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	for _, name := range []string{"testas1", "testas2"} {
+		err = storageClient.Add(newTestAutoscaler(name, "testns"))
+		require.NoError(t, err)
+	}
+	updateStatus("testas1", 2)
+
+	stream, err := client.WatchStatus(ctx, &prototypes.WatchStatusRequest{Namespace: "testns", Name: "testas1"})
+	require.NoError(t, err)
+
+	// current status
+	update, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "testas1", update.Name)
+	require.EqualValues(t, 2, update.Status.GetCurrentScale())
+
+	// updates for other autoscalers are filtered
+	updateStatus("testas2", 5)
+	updateStatus("testas1", 3)
+	update, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "testas1", update.Name)
+	require.EqualValues(t, 3, update.Status.GetCurrentScale())
+}
+
+func newTestAutoscaler(name, namespace string) *prototypes.Autoscaler {
+	return &prototypes.Autoscaler{
+		Name:      name,
+		Namespace: namespace,
+		Spec: &prototypes.AutoscalerSpec{
+			Min:     1,
+			Max:     10,
+			Metrics: []*prototypes.Metric{{Name: "testmetric", Target: 70}},
+		},
+	}
+}
+
+func newTestClient(t *testing.T, storageClient *storage.Client) (prototypes.AutoscalerServiceClient, func()) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer("", storageClient)
+	go server.grpcServer.Serve(listener)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	return prototypes.NewAutoscalerServiceClient(conn), func() {
+		conn.Close()
+		server.Stop(context.Background())
+		// stop is idempotent
+		server.Stop(context.Background())
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	api "k9s-autoscaler/pkg/http/openapi"
+	prototypes "k9s-autoscaler/pkg/proto"
+	storagetypes "k9s-autoscaler/pkg/storage/types"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// Interval at which keepalive comments are sent on idle event streams.
+	eventStreamKeepaliveInterval = 30 * time.Second
+)
+
+var (
+	_ api.ServerInterface = &Server{}
+)
+
+// Storage operations used by the server.
+type AutoscalerClient interface {
+	storagetypes.AutoscalerCRUDder
+	storagetypes.AutoscalerStatusGetter
+	storagetypes.AutoscalerWatcher
+}
+
+// An embedded http server that implements the autoscaler REST API defined in
+// pkg/http/openapi/api.yaml. Calls are mapped onto storage client CRUD operations.
+// Autoscalers are encoded using protojson such that provider configs can be
+// passed as typed google.protobuf.Any.
+type Server struct {
+	client     AutoscalerClient
+	httpServer *http.Server
+}
+
+// Creates a new server that listens on listenAddress and uses client for
+// autoscaler operations. Server must be started by calling Start().
+func NewServer(listenAddress string, client AutoscalerClient) *Server {
+	s := &Server{
+		client: client,
+	}
+	// long running event streams are not terminated by Shutdown() so they
+	// are stopped by cancelling their base context.
+	ctx, cancel := context.WithCancel(context.Background())
+	s.httpServer = &http.Server{
+		Addr:    listenAddress,
+		Handler: s.Handler(),
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+	s.httpServer.RegisterOnShutdown(cancel)
+
+	return s
+}
+
+// Returns an http handler with all API routes.
+func (s *Server) Handler() http.Handler {
+	return api.HandlerWithOptions(s, api.ChiServerOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, errors.NewBadRequest(err.Error()))
+		},
+	})
+}
+
+// Starts listening and serving requests in the background. Returns an error
+// if listening fails.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", s.httpServer.Addr, err)
+	}
+
+	go func() {
+		klog.V(1).InfoS("starting api server", "listen", listener.Addr().String())
+		err := s.httpServer.Serve(listener)
+		klog.V(1).InfoS("api server terminated", "error", err)
+	}()
+
+	return nil
+}
+
+// Gracefully stops the server.
+func (s *Server) Stop(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
+
+// Obtain a list of all defined autoscalers.
+// (GET /autoscalers)
+func (s *Server) GetAutoscalers(w http.ResponseWriter, r *http.Request) {
+	list, err := s.client.List()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Namespace != list[j].Namespace {
+			return list[i].Namespace < list[j].Namespace
+		}
+		return list[i].Name < list[j].Name
+	})
+
+	rawAutoscalers := make([]json.RawMessage, len(list))
+	for i, autoscaler := range list {
+		bytes, err := protojson.Marshal(autoscaler)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		rawAutoscalers[i] = bytes
+	}
+	body, err := json.Marshal(rawAutoscalers)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// Stream autoscaler changes as server-sent events.
+// (GET /autoscalers/events)
+func (s *Server) WatchAutoscalers(w http.ResponseWriter, r *http.Request, params api.WatchAutoscalersParams) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, fmt.Errorf("streaming is not supported"))
+		return
+	}
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	snapshot, watch, err := s.client.WatchAutoscalers(namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer watch.Stop()
+
+	klog.V(1).InfoS("starting event stream", "remote", r.RemoteAddr, "namespace", namespace)
+	defer klog.V(1).InfoS("event stream terminated", "remote", r.RemoteAddr, "namespace", namespace)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	rawAutoscalers := make([]json.RawMessage, len(snapshot))
+	for i, autoscaler := range snapshot {
+		if rawAutoscalers[i], err = protojson.Marshal(autoscaler); err != nil {
+			klog.InfoS("failed to marshal autoscaler", "error", err)
+			return
+		}
+	}
+	data, err := json.Marshal(rawAutoscalers)
+	if err != nil {
+		klog.InfoS("failed to marshal snapshot", "error", err)
+		return
+	}
+	if err := writeEvent(w, "SNAPSHOT", data); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	keepalive := time.NewTicker(eventStreamKeepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepalive.C:
+			if _, err := io.WriteString(w, ":keepalive\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-watch.ResultChan():
+			if !ok {
+				return
+			}
+			data, err := protojson.Marshal(event.Autoscaler)
+			if err != nil {
+				klog.InfoS("failed to marshal autoscaler", "error", err)
+				return
+			}
+			if err := writeEvent(w, string(event.Type), data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// Create a new autoscaler.
+// (POST /autoscalers)
+func (s *Server) CreateAutoscaler(w http.ResponseWriter, r *http.Request) {
+	autoscaler, err := readAutoscaler(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.client.Add(autoscaler); err != nil {
+		writeError(w, err)
+		return
+	}
+	created, err := s.client.Get(autoscaler.Name, autoscaler.Namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, http.StatusCreated, created)
+}
+
+// Delete an existing autoscaler.
+// (DELETE /autoscalers/{namespace}/{name})
+func (s *Server) DeleteAutoscaler(w http.ResponseWriter, r *http.Request, namespace api.Namespace, name api.Name) {
+	if err := s.client.Delete(name, namespace); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Obtain an autoscaler by namespace and name.
+// (GET /autoscalers/{namespace}/{name})
+func (s *Server) GetAutoscaler(w http.ResponseWriter, r *http.Request, namespace api.Namespace, name api.Name) {
+	autoscaler, err := s.client.Get(name, namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, http.StatusOK, autoscaler)
+}
+
+// Obtain current status of an autoscaler.
+// (GET /autoscalers/{namespace}/{name}/status)
+func (s *Server) GetAutoscalerStatus(w http.ResponseWriter, r *http.Request, namespace api.Namespace, name api.Name) {
+	status, err := s.client.GetStatus(name, namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, http.StatusOK, status)
+}
+
+// Obtain recent status snapshots of an autoscaler.
+// (GET /autoscalers/{namespace}/{name}/history)
+func (s *Server) GetAutoscalerHistory(w http.ResponseWriter, r *http.Request, namespace api.Namespace, name api.Name) {
+	history, err := s.client.GetStatusHistory(name, namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// status is encoded using protojson so api.StatusSnapshot cannot be used.
+	type statusSnapshot struct {
+		Time   time.Time       `json:"time"`
+		Status json.RawMessage `json:"status"`
+	}
+	snapshots := make([]statusSnapshot, len(history))
+	for i, snapshot := range history {
+		status, err := protojson.Marshal(snapshot.Status)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		snapshots[i] = statusSnapshot{
+			Time:   snapshot.Time,
+			Status: status,
+		}
+	}
+	body, err := json.Marshal(snapshots)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// Update an existing autoscaler.
+// (PUT /autoscalers/{namespace}/{name})
+func (s *Server) UpdateAutoscaler(w http.ResponseWriter, r *http.Request, namespace api.Namespace, name api.Name) {
+	autoscaler, err := readAutoscaler(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(autoscaler.Name) == 0 {
+		autoscaler.Name = name
+	}
+	if len(autoscaler.Namespace) == 0 {
+		autoscaler.Namespace = namespace
+	}
+	if autoscaler.Name != name || autoscaler.Namespace != namespace {
+		writeError(w, errors.NewBadRequest(fmt.Sprintf("autoscaler %s/%s does not match path %s/%s", autoscaler.Namespace, autoscaler.Name, namespace, name)))
+		return
+	}
+	if err := s.client.Update(autoscaler); err != nil {
+		writeError(w, err)
+		return
+	}
+	updated, err := s.client.Get(name, namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMessage(w, http.StatusOK, updated)
+}
+
+// Reads an autoscaler from request body. Status is read-only and is discarded.
+func readAutoscaler(r *http.Request) (*prototypes.Autoscaler, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	autoscaler := prototypes.Autoscaler{}
+	if err := protojson.Unmarshal(body, &autoscaler); err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid autoscaler: %v", err))
+	}
+	autoscaler.Status = nil
+
+	return &autoscaler, nil
+}
+
+func writeMessage(w http.ResponseWriter, code int, message proto.Message) {
+	body, err := protojson.Marshal(message)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, code, body)
+}
+
+// Writes err as an api Error. Status code is taken from k8s api errors, and
+// defaults to internal server error.
+func writeError(w http.ResponseWriter, err error) {
+	apiError := api.Error{
+		Code:    http.StatusInternalServerError,
+		Message: err.Error(),
+	}
+	if status, ok := err.(errors.APIStatus); ok {
+		apiError.Code = status.Status().Code
+		reason := string(status.Status().Reason)
+		apiError.Reason = &reason
+	}
+
+	body, err := json.Marshal(&apiError)
+	if err != nil {
+		klog.InfoS("failed to marshal api error", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, int(apiError.Code), body)
+}
+
+// Writes a single server-sent event. data must not contain new lines.
+func writeEvent(w io.Writer, event string, data []byte) error {
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	if err != nil {
+		klog.V(4).InfoS("failed to write event", "error", err)
+	}
+	return err
+}
+
+func writeJSON(w http.ResponseWriter, code int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(body); err != nil {
+		klog.V(4).InfoS("failed to write api response", "error", err)
+	}
+}
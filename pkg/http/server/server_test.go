@@ -0,0 +1,379 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "k9s-autoscaler/pkg/http/openapi"
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage"
+	"k9s-autoscaler/pkg/storage/mocks"
+	storagetypes "k9s-autoscaler/pkg/storage/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerCRUD(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+
+	httpServer := httptest.NewServer(NewServer("", storageClient).Handler())
+	defer httpServer.Close()
+	client, err := api.NewClient(httpServer.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	autoscalerJSON := `{"name": "testas", "namespace": "testns", "spec": {"min": 1, "max": 10, "metrics": [{"name": "testmetric", "target": 70}]}}`
+
+	// create
+	resp, err := client.CreateAutoscalerWithBody(ctx, "application/json", strings.NewReader(autoscalerJSON))
+	require.NoError(t, err)
+	autoscaler := readAutoscalerResponse(t, resp, http.StatusCreated)
+	require.Equal(t, "testas", autoscaler.Name)
+
+	// create duplicate
+	resp, err = client.CreateAutoscalerWithBody(ctx, "application/json", strings.NewReader(autoscalerJSON))
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusConflict)
+
+	// create invalid
+	resp, err = client.CreateAutoscalerWithBody(ctx, "application/json", strings.NewReader(`{"name": "testas2", "namespace": "testns"}`))
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusBadRequest)
+
+	// get
+	resp, err = client.GetAutoscaler(ctx, "testns", "testas")
+	require.NoError(t, err)
+	autoscaler = readAutoscalerResponse(t, resp, http.StatusOK)
+	require.EqualValues(t, 10, autoscaler.Spec.Max)
+
+	resp, err = client.GetAutoscaler(ctx, "testns", "none")
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusNotFound)
+
+	// list
+	resp, err = client.GetAutoscalers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+	var list []json.RawMessage
+	err = json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	resp, err = client.GetAutoscalers(ctx, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// update
+	resp, err = client.UpdateAutoscalerWithBody(ctx, "testns", "testas", "application/json", strings.NewReader(`{"spec": {"min": 1, "max": 20, "metrics": [{"name": "testmetric", "target": 70}]}}`))
+	require.NoError(t, err)
+	autoscaler = readAutoscalerResponse(t, resp, http.StatusOK)
+	require.EqualValues(t, 20, autoscaler.Spec.Max)
+	stored, err := storageClient.Get("testas", "testns")
+	require.NoError(t, err)
+	require.EqualValues(t, 20, stored.Spec.Max)
+
+	resp, err = client.UpdateAutoscalerWithBody(ctx, "testns", "testas", "application/json", strings.NewReader(`{"name": "other", "spec": {"min": 1, "max": 20, "metrics": [{"name": "testmetric", "target": 70}]}}`))
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusBadRequest)
+
+	resp, err = client.UpdateAutoscalerWithBody(ctx, "testns", "none", "application/json", strings.NewReader(`{"spec": {"min": 1, "max": 20, "metrics": [{"name": "testmetric", "target": 70}]}}`))
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusNotFound)
+
+	// list changed
+	resp, err = client.GetAutoscalers(ctx, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+	// delete
+	resp, err = client.DeleteAutoscaler(ctx, "testns", "testas")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = client.DeleteAutoscaler(ctx, "testns", "testas")
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusNotFound)
+}
+
+func TestServerStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+
+	httpServer := httptest.NewServer(NewServer("", storageClient).Handler())
+	defer httpServer.Close()
+	client, err := api.NewClient(httpServer.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = storageClient.Add(&prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testns",
+		Spec: &prototypes.AutoscalerSpec{
+			Min:     1,
+			Max:     10,
+			Metrics: []*prototypes.Metric{{Name: "testmetric", Target: 70}},
+		},
+	})
+	require.NoError(t, err)
+
+	// no status yet
+	resp, err := client.GetAutoscalerHistory(ctx, "testns", "testas")
+	require.NoError(t, err)
+	require.Empty(t, readHistoryResponse(t, resp))
+
+	for i := 1; i <= 3; i++ {
+		_, err = storageClient.HorizontalPodAutoscalers("testns").UpdateStatus(
+			ctx,
+			&v2.HorizontalPodAutoscaler{
+				ObjectMeta: v1.ObjectMeta{Name: "testas", Namespace: "testns"},
+				Status: v2.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: int32(i),
+					DesiredReplicas: int32(i + 1),
+					Conditions: []v2.HorizontalPodAutoscalerCondition{
+						{Type: v2.AbleToScale, Status: "True", Reason: "testreason"},
+					},
+				},
+			},
+			v1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	// status
+	resp, err = client.GetAutoscalerStatus(ctx, "testns", "testas")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	status := prototypes.AutoscalerStatus{}
+	err = protojson.Unmarshal(body, &status)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, status.GetCurrentScale())
+	require.EqualValues(t, 4, status.DesiredScale)
+	require.Len(t, status.Conditions, 1)
+	require.Equal(t, "testreason", status.Conditions[0].Reason)
+
+	resp, err = client.GetAutoscalerStatus(ctx, "testns", "none")
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusNotFound)
+
+	// history
+	resp, err = client.GetAutoscalerHistory(ctx, "testns", "testas")
+	require.NoError(t, err)
+	history := readHistoryResponse(t, resp)
+	require.Len(t, history, 3)
+	for i, snapshot := range history {
+		require.EqualValues(t, i+1, snapshot.Status.GetCurrentScale())
+		require.False(t, snapshot.Time.IsZero())
+	}
+
+	resp, err = client.GetAutoscalerHistory(ctx, "testns", "none")
+	require.NoError(t, err)
+	requireErrorResponse(t, resp, http.StatusNotFound)
+}
+
+func TestServerWatchAutoscalers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	storageClient, err := storage.NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer storageClient.Close()
+
+	httpServer := httptest.NewServer(NewServer("", storageClient).Handler())
+	defer httpServer.Close()
+	client, err := api.NewClient(httpServer.URL)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newAutoscaler := func(name, namespace string) *prototypes.Autoscaler {
+		return &prototypes.Autoscaler{
+			Name:      name,
+			Namespace: namespace,
+			Spec: &prototypes.AutoscalerSpec{
+				Min:     1,
+				Max:     10,
+				Metrics: []*prototypes.Metric{{Name: "testmetric", Target: 70}},
+			},
+		}
+	}
+	err = storageClient.Add(newAutoscaler("testas1", "testns"))
+	require.NoError(t, err)
+	err = storageClient.Add(newAutoscaler("testas2", "otherns"))
+	require.NoError(t, err)
+
+	namespace := "testns"
+	resp, err := client.WatchAutoscalers(ctx, &api.WatchAutoscalersParams{Namespace: &namespace})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	scanner := bufio.NewScanner(resp.Body)
+
+	// snapshot
+	event, data := readEvent(t, scanner)
+	require.Equal(t, "SNAPSHOT", event)
+	autoscalers, err := unmarshalAutoscalerList(data)
+	require.NoError(t, err)
+	require.Len(t, autoscalers, 1)
+	require.Equal(t, "testas1", autoscalers[0].Name)
+
+	// deltas
+	err = storageClient.Add(newAutoscaler("testas3", "otherns"))
+	require.NoError(t, err)
+	err = storageClient.Add(newAutoscaler("testas4", "testns"))
+	require.NoError(t, err)
+	_, err = storageClient.HorizontalPodAutoscalers("testns").UpdateStatus(
+		ctx,
+		&v2.HorizontalPodAutoscaler{
+			ObjectMeta: v1.ObjectMeta{Name: "testas1", Namespace: "testns"},
+			Status:     v2.HorizontalPodAutoscalerStatus{CurrentReplicas: 3},
+		},
+		v1.UpdateOptions{})
+	require.NoError(t, err)
+	err = storageClient.Delete("testas4", "testns")
+	require.NoError(t, err)
+
+	for _, expected := range []struct {
+		event string
+		name  string
+	}{
+		{"ADDED", "testas4"},
+		{"MODIFIED", "testas1"},
+		{"DELETED", "testas4"},
+	} {
+		event, data = readEvent(t, scanner)
+		require.Equal(t, expected.event, event)
+		autoscaler := prototypes.Autoscaler{}
+		err = protojson.Unmarshal(data, &autoscaler)
+		require.NoError(t, err)
+		require.Equal(t, expected.name, autoscaler.Name)
+		if event == "MODIFIED" {
+			require.EqualValues(t, 3, autoscaler.Status.GetCurrentScale())
+		}
+	}
+}
+
+// Reads next server-sent event skipping comments.
+func readEvent(t *testing.T, scanner *bufio.Scanner) (string, []byte) {
+	event, data := "", []byte(nil)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = []byte(strings.TrimPrefix(line, "data: "))
+		case len(line) == 0 && len(event) > 0:
+			return event, data
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.Fail(t, "event stream terminated")
+	return "", nil
+}
+
+func unmarshalAutoscalerList(data []byte) ([]*prototypes.Autoscaler, error) {
+	var rawAutoscalers []json.RawMessage
+	if err := json.Unmarshal(data, &rawAutoscalers); err != nil {
+		return nil, err
+	}
+	autoscalers := make([]*prototypes.Autoscaler, len(rawAutoscalers))
+	for i, raw := range rawAutoscalers {
+		autoscalers[i] = &prototypes.Autoscaler{}
+		if err := protojson.Unmarshal(raw, autoscalers[i]); err != nil {
+			return nil, err
+		}
+	}
+	return autoscalers, nil
+}
+
+func readAutoscalerResponse(t *testing.T, resp *http.Response, expectedCode int) *prototypes.Autoscaler {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, resp.StatusCode, string(body))
+	autoscaler := prototypes.Autoscaler{}
+	err = protojson.Unmarshal(body, &autoscaler)
+	require.NoError(t, err)
+
+	return &autoscaler
+}
+
+func requireErrorResponse(t *testing.T, resp *http.Response, expectedCode int) {
+	defer resp.Body.Close()
+
+	require.Equal(t, expectedCode, resp.StatusCode)
+	apiError := api.Error{}
+	err := json.NewDecoder(resp.Body).Decode(&apiError)
+	require.NoError(t, err)
+	require.EqualValues(t, expectedCode, apiError.Code)
+	require.NotEmpty(t, apiError.Message)
+}
+
+func readHistoryResponse(t *testing.T, resp *http.Response) []*storagetypes.AutoscalerStatusSnapshot {
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var rawSnapshots []struct {
+		Time   time.Time       `json:"time"`
+		Status json.RawMessage `json:"status"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&rawSnapshots)
+	require.NoError(t, err)
+
+	snapshots := make([]*storagetypes.AutoscalerStatusSnapshot, len(rawSnapshots))
+	for i, raw := range rawSnapshots {
+		status := prototypes.AutoscalerStatus{}
+		err := protojson.Unmarshal(raw.Status, &status)
+		require.NoError(t, err)
+		snapshots[i] = &storagetypes.AutoscalerStatusSnapshot{
+			Time:   raw.Time,
+			Status: &status,
+		}
+	}
+
+	return snapshots
+}
@@ -0,0 +1,203 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k9s-autoscaler/pkg/metrics/types"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/utils/clock"
+)
+
+var (
+	_ types.MetricsClient      = &cachedClient{}
+	_ types.BatchMetricsClient = &cachedBatchClient{}
+)
+
+// A metrics client that caches values returned by another client. Values are
+// shared by all autoscalers that request the same metric name with the same
+// config, and concurrent identical requests are coalesced into one.
+type cachedClient struct {
+	client types.MetricsClient
+	ttl    time.Duration
+	clock  clock.PassiveClock
+	group  singleflight.Group
+
+	// protects fields below.
+	lock      sync.Mutex
+	entries   map[string]*cacheEntry
+	lastPurge time.Time
+}
+
+// A cached client that also caches values of batch requests.
+type cachedBatchClient struct {
+	*cachedClient
+	batchClient types.BatchMetricsClient
+}
+
+type cacheEntry struct {
+	values    []float64
+	timestamp time.Time
+	expiry    time.Time
+}
+
+type cacheResult struct {
+	values    []float64
+	timestamp time.Time
+}
+
+// Create a new metrics client that caches values returned by client for ttl.
+// Cache key is made of the metric config type, which identifies the provider,
+// the metric name and the config serialized deterministically. Metrics that
+// client reports as autoscaler scoped also include the autoscaler namespace
+// and name in the key. Errors are not cached. If client implements
+// BatchMetricsClient, so does the returned client.
+func NewCachedClient(client types.MetricsClient, ttl time.Duration) types.MetricsClient {
+	return newCachedClient(client, ttl, clock.RealClock{})
+}
+
+func newCachedClient(client types.MetricsClient, ttl time.Duration, clock clock.PassiveClock) types.MetricsClient {
+	c := &cachedClient{
+		client:  client,
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]*cacheEntry),
+	}
+	if batchClient, ok := client.(types.BatchMetricsClient); ok {
+		return &cachedBatchClient{
+			cachedClient: c,
+			batchClient:  batchClient,
+		}
+	}
+
+	return c
+}
+
+func (c *cachedClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	key := c.cacheKey(metricName, autoscalerName, namespace, config)
+	if values, timestamp, ok := c.get(key, metricName); ok {
+		return values, timestamp, nil
+	}
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		values, timestamp, err := c.client.GetMetric(ctx, metricName, autoscalerName, namespace, config)
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, values, timestamp)
+		return &cacheResult{values: copyValues(values), timestamp: timestamp}, nil
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	cached := result.(*cacheResult)
+
+	// result may be shared by coalesced callers.
+	return copyValues(cached.values), cached.timestamp, nil
+}
+
+// Returns cached results where available and fetches the rest in a single
+// batch.
+func (c *cachedBatchClient) GetMetrics(ctx context.Context, requests []*types.MetricRequest) ([]*types.MetricResult, error) {
+	results := make([]*types.MetricResult, len(requests))
+	missed := []*types.MetricRequest{}
+	missedIndexes := []int{}
+	missedKeys := []string{}
+	for i, request := range requests {
+		key := c.cacheKey(request.MetricName, request.AutoscalerName, request.Namespace, request.Config)
+		if values, timestamp, ok := c.get(key, request.MetricName); ok {
+			results[i] = &types.MetricResult{Values: values, Timestamp: timestamp}
+			continue
+		}
+		missed = append(missed, request)
+		missedIndexes = append(missedIndexes, i)
+		missedKeys = append(missedKeys, key)
+	}
+	if len(missed) == 0 {
+		return results, nil
+	}
+
+	missedResults, err := c.batchClient.GetMetrics(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range missedResults {
+		if i >= len(missedIndexes) {
+			break
+		}
+		if result != nil && result.Err == nil {
+			c.set(missedKeys[i], result.Values, result.Timestamp)
+		}
+		results[missedIndexes[i]] = result
+	}
+
+	return results, nil
+}
+
+// Returns a copy of cached values of key if not expired.
+func (c *cachedClient) get(key, metricName string) ([]float64, time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.clock.Now().Before(entry.expiry) {
+		metricCacheMissesMetric.WithLabelValues(metricName).Inc()
+		return nil, time.Time{}, false
+	}
+	metricCacheHitsMetric.WithLabelValues(metricName).Inc()
+
+	return copyValues(entry.values), entry.timestamp, true
+}
+
+// Caches a copy of values for key. Expired entries are purged at most once
+// every ttl.
+func (c *cachedClient) set(key string, values []float64, timestamp time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	if now.Sub(c.lastPurge) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiry) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastPurge = now
+	}
+	c.entries[key] = &cacheEntry{
+		values:    copyValues(values),
+		timestamp: timestamp,
+		expiry:    now.Add(c.ttl),
+	}
+}
+
+// Returns cache key of a metric. Config is re-marshaled deterministically so
+// that equal configs with different field or map order share the key. Raw
+// config bytes are used if config cannot be unmarshaled.
+func (c *cachedClient) cacheKey(metricName, autoscalerName, namespace string, config *anypb.Any) string {
+	value := config.GetValue()
+	if message, err := config.UnmarshalNew(); err == nil {
+		if b, err := (proto.MarshalOptions{Deterministic: true}).Marshal(message); err == nil {
+			value = b
+		}
+	}
+	key := config.GetTypeUrl() + "\x00" + metricName + "\x00" + string(value)
+	if scopedClient, ok := c.client.(types.AutoscalerScopedMetricsClient); ok && scopedClient.AutoscalerScoped(config) {
+		key = namespace + "\x00" + autoscalerName + "\x00" + key
+	}
+
+	return key
+}
+
+func copyValues(values []float64) []float64 {
+	if values == nil {
+		return nil
+	}
+	return append(make([]float64, 0, len(values)), values...)
+}
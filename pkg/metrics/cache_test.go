@@ -0,0 +1,208 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/metrics/mocks"
+	"k9s-autoscaler/pkg/metrics/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestCachedClient(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.Background()
+	clock := testingclock.NewFakePassiveClock(time.Now())
+	callbackClient := mocks.NewMockMetricsClient(mockCtrl)
+	client := newCachedClient(callbackClient, 10*time.Second, clock)
+	_, ok := client.(types.BatchMetricsClient)
+	require.False(t, ok)
+
+	config1, err := anypb.New(&wrapperspb.StringValue{Value: "config1"})
+	require.NoError(t, err)
+	config2, err := anypb.New(&wrapperspb.StringValue{Value: "config2"})
+	require.NoError(t, err)
+	timestamp := clock.Now()
+
+	// values are shared by autoscalers with same metric name and config
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas1", "testns", config1).Return([]float64{1}, timestamp, nil)
+	values, ts, err := client.GetMetric(ctx, "metric1", "testas1", "testns", config1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, values)
+	require.Equal(t, timestamp, ts)
+	values[0] = 100
+	values, ts, err = client.GetMetric(ctx, "metric1", "testas2", "otherns", config1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, values)
+	require.Equal(t, timestamp, ts)
+
+	// different metric name or config are not shared
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric2", "testas1", "testns", config1).Return([]float64{2}, timestamp, nil)
+	values, _, err = client.GetMetric(ctx, "metric2", "testas1", "testns", config1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{2}, values)
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas1", "testns", config2).Return([]float64{3}, timestamp, nil)
+	values, _, err = client.GetMetric(ctx, "metric1", "testas1", "testns", config2)
+	require.NoError(t, err)
+	require.Equal(t, []float64{3}, values)
+
+	// errors are not cached
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric3", "testas1", "testns", config1).Return(nil, time.Time{}, fmt.Errorf("test error"))
+	_, _, err = client.GetMetric(ctx, "metric3", "testas1", "testns", config1)
+	require.Error(t, err)
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric3", "testas1", "testns", config1).Return([]float64{4}, timestamp, nil)
+	values, _, err = client.GetMetric(ctx, "metric3", "testas1", "testns", config1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{4}, values)
+
+	// expired values are fetched again
+	clock.SetTime(clock.Now().Add(10 * time.Second))
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas1", "testns", config1).Return([]float64{5}, clock.Now(), nil)
+	values, _, err = client.GetMetric(ctx, "metric1", "testas1", "testns", config1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{5}, values)
+}
+
+func TestCacheKey(t *testing.T) {
+	config, err := anypb.New(durationpb.New(time.Second + 2))
+	require.NoError(t, err)
+	// same config with fields in reverse order
+	value := protowire.AppendTag(nil, 2, protowire.VarintType)
+	value = protowire.AppendVarint(value, 2)
+	value = protowire.AppendTag(value, 1, protowire.VarintType)
+	value = protowire.AppendVarint(value, 1)
+	reordered := &anypb.Any{TypeUrl: config.TypeUrl, Value: value}
+	require.NotEqual(t, config.Value, reordered.Value)
+
+	c := &cachedClient{}
+	require.Equal(t, c.cacheKey("metric1", "testas1", "testns", config), c.cacheKey("metric1", "testas2", "otherns", reordered))
+}
+
+func TestCachedClientAutoscalerScoped(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.Background()
+	callbackClient := mocks.NewMockAutoscalerScopedMetricsClient(mockCtrl)
+	client := newCachedClient(callbackClient, 10*time.Second, testingclock.NewFakePassiveClock(time.Now()))
+	scoped, err := anypb.New(&wrapperspb.StringValue{Value: "scoped"})
+	require.NoError(t, err)
+	shared, err := anypb.New(&wrapperspb.StringValue{Value: "shared"})
+	require.NoError(t, err)
+	callbackClient.EXPECT().AutoscalerScoped(gomock.Any()).DoAndReturn(func(config *anypb.Any) bool {
+		return config == scoped
+	}).AnyTimes()
+
+	// scoped values are cached per autoscaler
+	for i, name := range []string{"testas1", "testas2", "testas1"} {
+		if i < 2 {
+			callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", name, "testns", scoped).Return([]float64{float64(i)}, time.Now(), nil)
+		}
+		values, _, err := client.GetMetric(ctx, "metric1", name, "testns", scoped)
+		require.NoError(t, err)
+		require.Equal(t, []float64{float64(i % 2)}, values)
+	}
+
+	// other values are still shared
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas1", "testns", shared).Return([]float64{10}, time.Now(), nil)
+	for _, name := range []string{"testas1", "testas2"} {
+		values, _, err := client.GetMetric(ctx, "metric1", name, "testns", shared)
+		require.NoError(t, err)
+		require.Equal(t, []float64{10}, values)
+	}
+}
+
+func TestCachedClientCoalescing(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	callbackClient := mocks.NewMockMetricsClient(mockCtrl)
+	client := NewCachedClient(callbackClient, time.Minute)
+	config, err := anypb.New(&wrapperspb.StringValue{Value: "config"})
+	require.NoError(t, err)
+
+	startedChan := make(chan struct{})
+	releaseChan := make(chan struct{})
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", gomock.Any(), "testns", config).DoAndReturn(
+		func(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+			close(startedChan)
+			<-releaseChan
+			return []float64{1}, time.Now(), nil
+		})
+
+	count := 5
+	wg := sync.WaitGroup{}
+	wg.Add(count)
+	results := make([][]float64, count)
+	errs := make([]error, count)
+	for i := 0; i < count; i++ {
+		go func(i int) {
+			defer wg.Done()
+			results[i], _, errs[i] = client.GetMetric(context.Background(), "metric1", fmt.Sprintf("testas%d", i), "testns", config)
+		}(i)
+		if i == 0 {
+			<-startedChan
+		}
+	}
+	// give waiters a chance to join the in-flight fetch
+	time.Sleep(100 * time.Millisecond)
+	close(releaseChan)
+	wg.Wait()
+
+	for i := 0; i < count; i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, []float64{1}, results[i])
+	}
+}
+
+func TestCachedClientBatch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	batchClient := &testBatchClient{MockMetricsClient: mocks.NewMockMetricsClient(mockCtrl)}
+	client, ok := newCachedClient(batchClient, 10*time.Second, testingclock.NewFakePassiveClock(time.Now())).(types.BatchMetricsClient)
+	require.True(t, ok)
+
+	config, err := anypb.New(&wrapperspb.StringValue{Value: "config"})
+	require.NoError(t, err)
+	requests := []*types.MetricRequest{
+		{MetricName: "metric1", AutoscalerName: "testas1", Namespace: "testns", Config: config},
+		{MetricName: "failed", AutoscalerName: "testas1", Namespace: "testns", Config: config},
+	}
+	results, err := client.GetMetrics(context.Background(), requests)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, []float64{100}, results[0].Values)
+	require.Error(t, results[1].Err)
+	require.Len(t, batchClient.batches, 1)
+
+	// only missed or failed metrics are fetched
+	requests = append(requests, &types.MetricRequest{MetricName: "metric2", AutoscalerName: "testas2", Namespace: "testns", Config: config})
+	results, err = client.GetMetrics(context.Background(), requests)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, []float64{100}, results[0].Values)
+	require.Error(t, results[1].Err)
+	require.Equal(t, []float64{201}, results[2].Values)
+	require.Len(t, batchClient.batches, 2)
+	require.Len(t, batchClient.batches[1], 2)
+
+	// cached batch values are used by GetMetric
+	values, _, err := client.GetMetric(context.Background(), "metric2", "testas3", "testns", config)
+	require.NoError(t, err)
+	require.Equal(t, []float64{201}, values)
+}
@@ -4,16 +4,31 @@ package metrics
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"sync"
 	"time"
 
+	eventstypes "k9s-autoscaler/pkg/events/types"
 	"k9s-autoscaler/pkg/metrics/types"
+	prototypes "k9s-autoscaler/pkg/proto"
 	"k9s-autoscaler/pkg/storage"
+	storagemetrics "k9s-autoscaler/pkg/storage/metrics"
 	storagetypes "k9s-autoscaler/pkg/storage/types"
 
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
 	metricsclient "k8s.io/kubernetes/pkg/controller/podautoscaler/metrics"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// Maximum age of a prefetched metric value before it is discarded.
+	prefetchMaxAge = 10 * time.Second
 )
 
 // Adapter partial implemenation of k8s MetricsClient. Since k9s autoscaler
@@ -23,15 +38,62 @@ type client struct {
 
 	autoscalerGetter storagetypes.AutoscalerGetter
 	callbackClient   types.MetricsClient
+	batchClient      types.BatchMetricsClient
+	eventCreator     eventstypes.EventCreator
+	clock            clock.PassiveClock
+
+	// serializes prefetches so concurrent workers wait for a single batch.
+	prefetchLock sync.Mutex
+	// protects fields below.
+	lock       sync.Mutex
+	prefetched map[prefetchKey]*prefetchedMetric
+	// metrics that the batch client could not handle in last prefetch.
+	unbatchable map[prefetchKey]bool
+	// time of last failed prefetch. Prefetch is not retried for prefetchMaxAge.
+	lastPrefetchFailure time.Time
+	// metrics whose last value was stale. Stale events are only reported
+	// when a metric becomes stale.
+	stale map[prefetchKey]bool
+}
+
+type prefetchKey struct {
+	namespace      string
+	autoscalerName string
+	metricName     string
+}
+
+type prefetchedMetric struct {
+	result    *types.MetricResult
+	fetchTime time.Time
 }
 
 // Create a new adapter k8s MetricsClient that calls callbackClient to get metric
-// values.
-func NewClient(autoscalerGetter storagetypes.AutoscalerGetter, callbackClient types.MetricsClient) metricsclient.MetricsClient {
-	return &client{
+// values. If callbackClient implements BatchMetricsClient, values of all
+// autoscaler metrics are prefetched in a single batch when a metric that was
+// not prefetched is requested. Each prefetched value is used at most once,
+// and for no longer than prefetchMaxAge. If eventCreator is not nil, it is
+// used to report metrics that become stale.
+func NewClient(autoscalerGetter storagetypes.AutoscalerGetter, callbackClient types.MetricsClient, eventCreator eventstypes.EventCreator) metricsclient.MetricsClient {
+	return NewClientWithClock(autoscalerGetter, callbackClient, eventCreator, clock.RealClock{})
+}
+
+// Create a new adapter like NewClient() that uses clock for metric ages and
+// prefetch expiry.
+func NewClientWithClock(autoscalerGetter storagetypes.AutoscalerGetter, callbackClient types.MetricsClient, eventCreator eventstypes.EventCreator, clock clock.PassiveClock) metricsclient.MetricsClient {
+	c := &client{
 		autoscalerGetter: autoscalerGetter,
 		callbackClient:   callbackClient,
+		eventCreator:     eventCreator,
+		clock:            clock,
+		prefetched:       make(map[prefetchKey]*prefetchedMetric),
+		unbatchable:      make(map[prefetchKey]bool),
+		stale:            make(map[prefetchKey]bool),
+	}
+	if batchClient, ok := callbackClient.(types.BatchMetricsClient); ok {
+		c.batchClient = batchClient
 	}
+
+	return c
 }
 
 func (c *client) GetExternalMetric(metricName string, namespace string, selector labels.Selector) ([]int64, time.Time, error) {
@@ -42,9 +104,11 @@ func (c *client) GetExternalMetric(metricName string, namespace string, selector
 	}
 
 	var config *anypb.Any
+	var maxAge time.Duration
 	for _, metric := range as.Spec.Metrics {
 		if metric.Name == metricName {
 			config = metric.Config
+			maxAge = metric.MaxAge.AsDuration()
 			break
 		}
 	}
@@ -53,7 +117,7 @@ func (c *client) GetExternalMetric(metricName string, namespace string, selector
 	}
 
 	startTime := time.Now()
-	values, ts, err := c.callbackClient.GetMetric(
+	floatValues, ts, err := c.getMetric(
 		context.TODO(),
 		metricName,
 		autoscalerName,
@@ -65,10 +129,180 @@ func (c *client) GetExternalMetric(metricName string, namespace string, selector
 	}
 	metricLatencyMetric.WithLabelValues(namespace, metricName, "").Observe(float64(time.Since(startTime)))
 
+	if !ts.IsZero() {
+		age := c.clock.Since(ts)
+		storagemetrics.MetricAgeMetric.WithLabelValues(autoscalerName, namespace, metricName).Set(age.Seconds())
+		key := prefetchKey{namespace: namespace, autoscalerName: autoscalerName, metricName: metricName}
+		if maxAge > 0 && age > maxAge {
+			err := fmt.Errorf("metric %s is stale: value is %v old, max age is %v", metricName, age.Truncate(time.Second), maxAge)
+			if c.setStale(key, true) {
+				c.reportStaleMetric(autoscalerName, namespace, err)
+			}
+			return nil, time.Time{}, err
+		}
+		c.setStale(key, false)
+	}
+
 	// autoscaler expect millis representation
-	for i, value := range values {
-		values[i] = value * 1000
+	values := make([]int64, len(floatValues))
+	for i, value := range floatValues {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, time.Time{}, fmt.Errorf("invalid value %v for metric %s", value, metricName)
+		}
+		values[i] = int64(math.Round(value * 1000))
 	}
 
 	return values, ts, nil
 }
+
+// Sets whether metric of key is stale. Returns true if metric became stale.
+func (c *client) setStale(key prefetchKey, stale bool) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !stale {
+		delete(c.stale, key)
+		return false
+	}
+	if c.stale[key] {
+		return false
+	}
+	c.stale[key] = true
+
+	return true
+}
+
+// Emits a warning event for a stale metric.
+func (c *client) reportStaleMetric(autoscalerName, namespace string, err error) {
+	klog.InfoS("stale metric", "autoscaler", autoscalerName, "namespace", namespace, "error", err)
+	if c.eventCreator == nil {
+		return
+	}
+	now := timestamppb.Now()
+	event := &prototypes.AutoscalerEvent{
+		Reason:         "MetricStale",
+		Message:        err.Error(),
+		Type:           v1.EventTypeWarning,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		EventTime:      now,
+		Count:          1,
+	}
+	if err := c.eventCreator.Create(context.TODO(), autoscalerName, namespace, event); err != nil {
+		klog.InfoS("failed to create stale metric event", "autoscaler", autoscalerName, "namespace", namespace, "error", err)
+	}
+}
+
+// Gets metric values from prefetched batch if possible, otherwise from the
+// callback client.
+func (c *client) getMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	if c.batchClient != nil {
+		key := prefetchKey{
+			namespace:      namespace,
+			autoscalerName: autoscalerName,
+			metricName:     metricName,
+		}
+		result, ok, shouldPrefetch := c.takePrefetched(key)
+		if !ok && shouldPrefetch {
+			c.prefetchLock.Lock()
+			result, ok, shouldPrefetch = c.takePrefetched(key)
+			if !ok && shouldPrefetch {
+				c.prefetch(ctx)
+				result, ok, _ = c.takePrefetched(key)
+			}
+			c.prefetchLock.Unlock()
+		}
+		if ok {
+			return result.Values, result.Timestamp, result.Err
+		}
+	}
+
+	return c.callbackClient.GetMetric(ctx, metricName, autoscalerName, namespace, config)
+}
+
+// Takes a prefetched result for key if available. Otherwise returns whether a
+// prefetch could provide it.
+func (c *client) takePrefetched(key prefetchKey) (*types.MetricResult, bool, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	prefetched, ok := c.prefetched[key]
+	if ok {
+		delete(c.prefetched, key)
+		if c.clock.Since(prefetched.fetchTime) <= prefetchMaxAge {
+			return prefetched.result, true, false
+		}
+	}
+	shouldPrefetch := !c.unbatchable[key] && c.clock.Since(c.lastPrefetchFailure) > prefetchMaxAge
+
+	return nil, false, shouldPrefetch
+}
+
+// Fetches values of all metrics of all autoscalers in a single batch. Metrics
+// that cannot be batched are skipped.
+func (c *client) prefetch(ctx context.Context) {
+	autoscalers, err := c.autoscalerGetter.List()
+	if err != nil {
+		klog.InfoS("failed to list autoscalers for metrics prefetch", "error", err)
+		return
+	}
+	keys := []prefetchKey{}
+	requests := []*types.MetricRequest{}
+	for _, as := range autoscalers {
+		if as.Spec == nil {
+			continue
+		}
+		for _, metric := range as.Spec.Metrics {
+			if metric.Config == nil {
+				continue
+			}
+			keys = append(keys, prefetchKey{
+				namespace:      as.Namespace,
+				autoscalerName: as.Name,
+				metricName:     metric.Name,
+			})
+			requests = append(requests, &types.MetricRequest{
+				MetricName:     metric.Name,
+				AutoscalerName: as.Name,
+				Namespace:      as.Namespace,
+				Config:         metric.Config,
+			})
+		}
+	}
+	if len(requests) == 0 {
+		return
+	}
+
+	startTime := time.Now()
+	fetchTime := c.clock.Now()
+	results, err := c.batchClient.GetMetrics(ctx, requests)
+	if err == nil && len(results) != len(requests) {
+		err = fmt.Errorf("mismatched number of batch results: %d != %d", len(results), len(requests))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err != nil {
+		metricPrefetchLatencyMetric.WithLabelValues("true").Observe(time.Since(startTime).Seconds())
+		klog.InfoS("failed to prefetch metrics", "count", len(requests), "error", err)
+		c.lastPrefetchFailure = c.clock.Now()
+		return
+	}
+	metricPrefetchLatencyMetric.WithLabelValues("").Observe(time.Since(startTime).Seconds())
+
+	count := 0
+	c.unbatchable = make(map[prefetchKey]bool)
+	for i, result := range results {
+		if result == nil || errors.Is(result.Err, types.ErrBatchNotSupported) {
+			c.unbatchable[keys[i]] = true
+			continue
+		}
+		c.prefetched[keys[i]] = &prefetchedMetric{
+			result:    result,
+			fetchTime: fetchTime,
+		}
+		count++
+	}
+	klog.V(4).InfoS("prefetched metrics", "requested", len(requests), "prefetched", count)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	eventsmocks "k9s-autoscaler/pkg/events/mocks"
+	"k9s-autoscaler/pkg/metrics/mocks"
+	"k9s-autoscaler/pkg/metrics/types"
+	prototypes "k9s-autoscaler/pkg/proto"
+	storagemocks "k9s-autoscaler/pkg/storage/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// A batch client that returns the request index as value and does not
+// support batching metrics named "nobatch".
+type testBatchClient struct {
+	*mocks.MockMetricsClient
+	batches [][]*types.MetricRequest
+	fail    bool
+}
+
+func (c *testBatchClient) GetMetrics(ctx context.Context, requests []*types.MetricRequest) ([]*types.MetricResult, error) {
+	c.batches = append(c.batches, requests)
+	if c.fail {
+		return nil, fmt.Errorf("test batch error")
+	}
+	results := make([]*types.MetricResult, len(requests))
+	for i, request := range requests {
+		switch request.MetricName {
+		case "nobatch":
+			results[i] = &types.MetricResult{Err: types.ErrBatchNotSupported}
+		case "failed":
+			results[i] = &types.MetricResult{Err: fmt.Errorf("test error")}
+		default:
+			results[i] = &types.MetricResult{Values: []float64{float64(len(c.batches)*100 + i)}, Timestamp: time.Now()}
+		}
+	}
+	return results, nil
+}
+
+func TestClientPrefetch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	config, err := anypb.New(&wrapperspb.StringValue{})
+	require.NoError(t, err)
+	autoscalers := []*prototypes.Autoscaler{
+		{
+			Name:      "testas1",
+			Namespace: "testns",
+			Spec: &prototypes.AutoscalerSpec{
+				Metrics: []*prototypes.Metric{
+					{Name: "metric1", Config: config},
+					{Name: "nobatch", Config: config},
+				},
+			},
+		},
+		{
+			Name:      "testas2",
+			Namespace: "testns",
+			Spec: &prototypes.AutoscalerSpec{
+				Metrics: []*prototypes.Metric{
+					{Name: "metric1", Config: config},
+					{Name: "failed", Config: config},
+				},
+			},
+		},
+	}
+	autoscalerGetter := storagemocks.NewMockAutoscalerGetter(mockCtrl)
+	autoscalerGetter.EXPECT().List().Return(autoscalers, nil).AnyTimes()
+	for _, as := range autoscalers {
+		autoscalerGetter.EXPECT().Get(as.Name, as.Namespace).Return(as, nil).AnyTimes()
+	}
+	batchClient := &testBatchClient{MockMetricsClient: mocks.NewMockMetricsClient(mockCtrl)}
+	client := NewClient(autoscalerGetter, batchClient, nil)
+
+	selector := func(name string) labels.Selector {
+		return labels.SelectorFromSet(labels.Set{"hpa": name})
+	}
+
+	// first request prefetches all metrics
+	values, _, err := client.GetExternalMetric("metric1", "testns", selector("testas2"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{102000}, values)
+	require.Len(t, batchClient.batches, 1)
+	require.Len(t, batchClient.batches[0], 4)
+
+	values, _, err = client.GetExternalMetric("metric1", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{100000}, values)
+	_, _, err = client.GetExternalMetric("failed", "testns", selector("testas2"))
+	require.Error(t, err)
+	require.Len(t, batchClient.batches, 1)
+
+	// metrics not supporting batching fall back to GetMetric
+	batchClient.MockMetricsClient.EXPECT().GetMetric(gomock.Any(), "nobatch", "testas1", "testns", config).Return([]float64{7}, time.Now(), nil)
+	values, _, err = client.GetExternalMetric("nobatch", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{7000}, values)
+	require.Len(t, batchClient.batches, 1)
+
+	// prefetched values are used once
+	values, _, err = client.GetExternalMetric("metric1", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{200000}, values)
+	values, _, err = client.GetExternalMetric("metric1", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{300000}, values)
+	require.Len(t, batchClient.batches, 3)
+
+	// failed batches fall back to GetMetric and are not retried immediately
+	batchClient.fail = true
+	batchClient.MockMetricsClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas1", "testns", config).Return([]float64{8}, time.Now(), nil).Times(2)
+	values, _, err = client.GetExternalMetric("metric1", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{8000}, values)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector("testas1"))
+	require.NoError(t, err)
+	require.Len(t, batchClient.batches, 4)
+}
+
+func TestClientFractionalValues(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	config, err := anypb.New(&wrapperspb.StringValue{})
+	require.NoError(t, err)
+	autoscalerGetter := storagemocks.NewMockAutoscalerGetter(mockCtrl)
+	autoscalerGetter.EXPECT().Get("testas", "testns").Return(&prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testns",
+		Spec: &prototypes.AutoscalerSpec{
+			Metrics: []*prototypes.Metric{{Name: "metric1", Config: config}},
+		},
+	}, nil).AnyTimes()
+	callbackClient := mocks.NewMockMetricsClient(mockCtrl)
+	client := NewClient(autoscalerGetter, callbackClient, nil)
+	selector := labels.SelectorFromSet(labels.Set{"hpa": "testas"})
+
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{0.25, 1.5, 0.0004}, time.Now(), nil)
+	values, _, err := client.GetExternalMetric("metric1", "testns", selector)
+	require.NoError(t, err)
+	require.Equal(t, []int64{250, 1500, 0}, values)
+
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{math.NaN()}, time.Now(), nil)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+}
+
+func TestClientStaleMetric(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	config, err := anypb.New(&wrapperspb.StringValue{})
+	require.NoError(t, err)
+	autoscalerGetter := storagemocks.NewMockAutoscalerGetter(mockCtrl)
+	autoscalerGetter.EXPECT().Get("testas", "testns").Return(&prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testns",
+		Spec: &prototypes.AutoscalerSpec{
+			Metrics: []*prototypes.Metric{
+				{Name: "metric1", Config: config, MaxAge: durationpb.New(time.Minute)},
+				{Name: "metric2", Config: config},
+			},
+		},
+	}, nil).AnyTimes()
+	callbackClient := mocks.NewMockMetricsClient(mockCtrl)
+	eventCreator := eventsmocks.NewMockEventCreator(mockCtrl)
+	client := NewClient(autoscalerGetter, callbackClient, eventCreator)
+	selector := labels.SelectorFromSet(labels.Set{"hpa": "testas"})
+
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{1}, time.Now().Add(-30*time.Second), nil)
+	values, _, err := client.GetExternalMetric("metric1", "testns", selector)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1000}, values)
+
+	// stale values are failures and reported as events
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{1}, time.Now().Add(-2*time.Minute), nil)
+	eventCreator.EXPECT().Create(gomock.Any(), "testas", "testns", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name, namespace string, event *prototypes.AutoscalerEvent) error {
+			require.Equal(t, "MetricStale", event.Reason)
+			require.Equal(t, "Warning", event.Type)
+			return nil
+		})
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+
+	// event is reported only when metric becomes stale
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{1}, time.Now().Add(-2*time.Minute), nil)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{1}, time.Now(), nil)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.NoError(t, err)
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", config).Return([]float64{1}, time.Now().Add(-2*time.Minute), nil)
+	eventCreator.EXPECT().Create(gomock.Any(), "testas", "testns", gomock.Any()).Return(nil)
+	_, _, err = client.GetExternalMetric("metric1", "testns", selector)
+	require.Error(t, err)
+
+	// metrics without max age are never stale
+	callbackClient.EXPECT().GetMetric(gomock.Any(), "metric2", "testas", "testns", config).Return([]float64{2}, time.Now().Add(-time.Hour), nil)
+	values, _, err = client.GetExternalMetric("metric2", "testns", selector)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2000}, values)
+}
@@ -23,4 +23,29 @@ var (
 			Buckets:   prometheus.ExponentialBucketsRange(0.001, 60.0, 16),
 		},
 		[]string{common.MetricsAutoscalerNamespaceLabel, metricNameLabel, common.MetricsErrorLabel})
+	metricPrefetchLatencyMetric = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: common.MetricsNamespace,
+			Subsystem: "metrics",
+			Name:      "prefetch_latency",
+			Help:      "Time to prefetch a batch of metric values.",
+			Buckets:   prometheus.ExponentialBucketsRange(0.001, 60.0, 16),
+		},
+		[]string{common.MetricsErrorLabel})
+	metricCacheHitsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: common.MetricsNamespace,
+			Subsystem: "metrics",
+			Name:      "cache_hits",
+			Help:      "Number of metric values served from cache.",
+		},
+		[]string{metricNameLabel})
+	metricCacheMissesMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: common.MetricsNamespace,
+			Subsystem: "metrics",
+			Name:      "cache_misses",
+			Help:      "Number of metric values not found in cache.",
+		},
+		[]string{metricNameLabel})
 )
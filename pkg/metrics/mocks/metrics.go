@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	types "k9s-autoscaler/pkg/metrics/types"
 	reflect "reflect"
 	time "time"
 
@@ -37,17 +38,124 @@ func (m *MockMetricsClient) EXPECT() *MockMetricsClientMockRecorder {
 }
 
 // GetMetric mocks base method.
-func (m *MockMetricsClient) GetMetric(ctx context.Context, metricName, autoscalername, namespace string, config *anypb.Any) ([]int64, time.Time, error) {
+func (m *MockMetricsClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMetric", ctx, metricName, autoscalername, namespace, config)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "GetMetric", ctx, metricName, autoscalerName, namespace, config)
+	ret0, _ := ret[0].([]float64)
 	ret1, _ := ret[1].(time.Time)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // GetMetric indicates an expected call of GetMetric.
-func (mr *MockMetricsClientMockRecorder) GetMetric(ctx, metricName, autoscalername, namespace, config interface{}) *gomock.Call {
+func (mr *MockMetricsClientMockRecorder) GetMetric(ctx, metricName, autoscalerName, namespace, config interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetric", reflect.TypeOf((*MockMetricsClient)(nil).GetMetric), ctx, metricName, autoscalername, namespace, config)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetric", reflect.TypeOf((*MockMetricsClient)(nil).GetMetric), ctx, metricName, autoscalerName, namespace, config)
+}
+
+// MockBatchMetricsClient is a mock of BatchMetricsClient interface.
+type MockBatchMetricsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchMetricsClientMockRecorder
+}
+
+// MockBatchMetricsClientMockRecorder is the mock recorder for MockBatchMetricsClient.
+type MockBatchMetricsClientMockRecorder struct {
+	mock *MockBatchMetricsClient
+}
+
+// NewMockBatchMetricsClient creates a new mock instance.
+func NewMockBatchMetricsClient(ctrl *gomock.Controller) *MockBatchMetricsClient {
+	mock := &MockBatchMetricsClient{ctrl: ctrl}
+	mock.recorder = &MockBatchMetricsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchMetricsClient) EXPECT() *MockBatchMetricsClientMockRecorder {
+	return m.recorder
+}
+
+// GetMetric mocks base method.
+func (m *MockBatchMetricsClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetric", ctx, metricName, autoscalerName, namespace, config)
+	ret0, _ := ret[0].([]float64)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMetric indicates an expected call of GetMetric.
+func (mr *MockBatchMetricsClientMockRecorder) GetMetric(ctx, metricName, autoscalerName, namespace, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetric", reflect.TypeOf((*MockBatchMetricsClient)(nil).GetMetric), ctx, metricName, autoscalerName, namespace, config)
+}
+
+// GetMetrics mocks base method.
+func (m *MockBatchMetricsClient) GetMetrics(ctx context.Context, requests []*types.MetricRequest) ([]*types.MetricResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetrics", ctx, requests)
+	ret0, _ := ret[0].([]*types.MetricResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetrics indicates an expected call of GetMetrics.
+func (mr *MockBatchMetricsClientMockRecorder) GetMetrics(ctx, requests interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetrics", reflect.TypeOf((*MockBatchMetricsClient)(nil).GetMetrics), ctx, requests)
+}
+
+// MockAutoscalerScopedMetricsClient is a mock of AutoscalerScopedMetricsClient interface.
+type MockAutoscalerScopedMetricsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerScopedMetricsClientMockRecorder
+}
+
+// MockAutoscalerScopedMetricsClientMockRecorder is the mock recorder for MockAutoscalerScopedMetricsClient.
+type MockAutoscalerScopedMetricsClientMockRecorder struct {
+	mock *MockAutoscalerScopedMetricsClient
+}
+
+// NewMockAutoscalerScopedMetricsClient creates a new mock instance.
+func NewMockAutoscalerScopedMetricsClient(ctrl *gomock.Controller) *MockAutoscalerScopedMetricsClient {
+	mock := &MockAutoscalerScopedMetricsClient{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerScopedMetricsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscalerScopedMetricsClient) EXPECT() *MockAutoscalerScopedMetricsClientMockRecorder {
+	return m.recorder
+}
+
+// AutoscalerScoped mocks base method.
+func (m *MockAutoscalerScopedMetricsClient) AutoscalerScoped(config *anypb.Any) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoscalerScoped", config)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AutoscalerScoped indicates an expected call of AutoscalerScoped.
+func (mr *MockAutoscalerScopedMetricsClientMockRecorder) AutoscalerScoped(config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoscalerScoped", reflect.TypeOf((*MockAutoscalerScopedMetricsClient)(nil).AutoscalerScoped), config)
+}
+
+// GetMetric mocks base method.
+func (m *MockAutoscalerScopedMetricsClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetric", ctx, metricName, autoscalerName, namespace, config)
+	ret0, _ := ret[0].([]float64)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMetric indicates an expected call of GetMetric.
+func (mr *MockAutoscalerScopedMetricsClientMockRecorder) GetMetric(ctx, metricName, autoscalerName, namespace, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetric", reflect.TypeOf((*MockAutoscalerScopedMetricsClient)(nil).GetMetric), ctx, metricName, autoscalerName, namespace, config)
 }
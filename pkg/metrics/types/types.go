@@ -4,6 +4,7 @@ package types
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
@@ -15,6 +16,46 @@ import (
 // values.
 type MetricsClient interface {
 	// Get metric values metricName in namespace using opaque provider configs. Returns an array
-	// of values and values timestamp.
-	GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]int64, time.Time, error)
+	// of values and values timestamp. Values may be fractional.
+	GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error)
+}
+
+// Returned in MetricResult.Err by batch clients that cannot handle a request
+// as part of a batch. Callers should fall back to GetMetric().
+var ErrBatchNotSupported = errors.New("metric does not support batching")
+
+// A single metric request.
+type MetricRequest struct {
+	MetricName     string
+	AutoscalerName string
+	Namespace      string
+	// Opaque provider metric config.
+	Config *anypb.Any
+}
+
+// Result of a single metric request.
+type MetricResult struct {
+	Values    []float64
+	Timestamp time.Time
+	Err       error
+}
+
+// An optional interface that metrics clients can implement to fetch values
+// of many metrics in a single call.
+type BatchMetricsClient interface {
+	MetricsClient
+	// Get values of all requests. Returned results correspond to requests by
+	// index. Failure of individual requests is reported in their result Err.
+	// Returns an error only if the whole batch failed.
+	GetMetrics(ctx context.Context, requests []*MetricRequest) ([]*MetricResult, error)
+}
+
+// An optional interface that metrics clients can implement if values of a
+// metric config depend on the requesting autoscaler, for example through
+// per-autoscaler placeholders. Cached values of such metrics are not shared
+// between autoscalers.
+type AutoscalerScopedMetricsClient interface {
+	MetricsClient
+	// Returns true if values of config depend on the requesting autoscaler.
+	AutoscalerScoped(config *anypb.Any) bool
 }
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.0--rc2
+// source: autoscaler_service.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AutoscalerServiceClient is the client API for AutoscalerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AutoscalerServiceClient interface {
+	// List defined autoscalers.
+	List(ctx context.Context, in *ListAutoscalersRequest, opts ...grpc.CallOption) (*ListAutoscalersResponse, error)
+	// Get an autoscaler by name and namespace.
+	Get(ctx context.Context, in *GetAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error)
+	// Create a new autoscaler. Status is ignored.
+	Create(ctx context.Context, in *CreateAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error)
+	// Update an existing autoscaler. Status is ignored.
+	Update(ctx context.Context, in *UpdateAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error)
+	// Delete an existing autoscaler.
+	Delete(ctx context.Context, in *DeleteAutoscalerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Stream status of matching autoscalers. Current status of each matching
+	// autoscaler is sent first, followed by all subsequent status updates.
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (AutoscalerService_WatchStatusClient, error)
+}
+
+type autoscalerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAutoscalerServiceClient(cc grpc.ClientConnInterface) AutoscalerServiceClient {
+	return &autoscalerServiceClient{cc}
+}
+
+func (c *autoscalerServiceClient) List(ctx context.Context, in *ListAutoscalersRequest, opts ...grpc.CallOption) (*ListAutoscalersResponse, error) {
+	out := new(ListAutoscalersResponse)
+	err := c.cc.Invoke(ctx, "/k9sautoscaler.proto.AutoscalerService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoscalerServiceClient) Get(ctx context.Context, in *GetAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error) {
+	out := new(Autoscaler)
+	err := c.cc.Invoke(ctx, "/k9sautoscaler.proto.AutoscalerService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoscalerServiceClient) Create(ctx context.Context, in *CreateAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error) {
+	out := new(Autoscaler)
+	err := c.cc.Invoke(ctx, "/k9sautoscaler.proto.AutoscalerService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoscalerServiceClient) Update(ctx context.Context, in *UpdateAutoscalerRequest, opts ...grpc.CallOption) (*Autoscaler, error) {
+	out := new(Autoscaler)
+	err := c.cc.Invoke(ctx, "/k9sautoscaler.proto.AutoscalerService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoscalerServiceClient) Delete(ctx context.Context, in *DeleteAutoscalerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/k9sautoscaler.proto.AutoscalerService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoscalerServiceClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (AutoscalerService_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AutoscalerService_ServiceDesc.Streams[0], "/k9sautoscaler.proto.AutoscalerService/WatchStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autoscalerServiceWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AutoscalerService_WatchStatusClient interface {
+	Recv() (*AutoscalerStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type autoscalerServiceWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *autoscalerServiceWatchStatusClient) Recv() (*AutoscalerStatusUpdate, error) {
+	m := new(AutoscalerStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AutoscalerServiceServer is the server API for AutoscalerService service.
+// All implementations must embed UnimplementedAutoscalerServiceServer
+// for forward compatibility
+type AutoscalerServiceServer interface {
+	// List defined autoscalers.
+	List(context.Context, *ListAutoscalersRequest) (*ListAutoscalersResponse, error)
+	// Get an autoscaler by name and namespace.
+	Get(context.Context, *GetAutoscalerRequest) (*Autoscaler, error)
+	// Create a new autoscaler. Status is ignored.
+	Create(context.Context, *CreateAutoscalerRequest) (*Autoscaler, error)
+	// Update an existing autoscaler. Status is ignored.
+	Update(context.Context, *UpdateAutoscalerRequest) (*Autoscaler, error)
+	// Delete an existing autoscaler.
+	Delete(context.Context, *DeleteAutoscalerRequest) (*emptypb.Empty, error)
+	// Stream status of matching autoscalers. Current status of each matching
+	// autoscaler is sent first, followed by all subsequent status updates.
+	WatchStatus(*WatchStatusRequest, AutoscalerService_WatchStatusServer) error
+	mustEmbedUnimplementedAutoscalerServiceServer()
+}
+
+// UnimplementedAutoscalerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAutoscalerServiceServer struct {
+}
+
+func (UnimplementedAutoscalerServiceServer) List(context.Context, *ListAutoscalersRequest) (*ListAutoscalersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) Get(context.Context, *GetAutoscalerRequest) (*Autoscaler, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) Create(context.Context, *CreateAutoscalerRequest) (*Autoscaler, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) Update(context.Context, *UpdateAutoscalerRequest) (*Autoscaler, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) Delete(context.Context, *DeleteAutoscalerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) WatchStatus(*WatchStatusRequest, AutoscalerService_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedAutoscalerServiceServer) mustEmbedUnimplementedAutoscalerServiceServer() {}
+
+// UnsafeAutoscalerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AutoscalerServiceServer will
+// result in compilation errors.
+type UnsafeAutoscalerServiceServer interface {
+	mustEmbedUnimplementedAutoscalerServiceServer()
+}
+
+func RegisterAutoscalerServiceServer(s grpc.ServiceRegistrar, srv AutoscalerServiceServer) {
+	s.RegisterService(&AutoscalerService_ServiceDesc, srv)
+}
+
+func _AutoscalerService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoscalersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoscalerServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/k9sautoscaler.proto.AutoscalerService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoscalerServiceServer).List(ctx, req.(*ListAutoscalersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoscalerService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutoscalerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoscalerServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/k9sautoscaler.proto.AutoscalerService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoscalerServiceServer).Get(ctx, req.(*GetAutoscalerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoscalerService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutoscalerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoscalerServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/k9sautoscaler.proto.AutoscalerService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoscalerServiceServer).Create(ctx, req.(*CreateAutoscalerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoscalerService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutoscalerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoscalerServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/k9sautoscaler.proto.AutoscalerService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoscalerServiceServer).Update(ctx, req.(*UpdateAutoscalerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoscalerService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoscalerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoscalerServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/k9sautoscaler.proto.AutoscalerService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoscalerServiceServer).Delete(ctx, req.(*DeleteAutoscalerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoscalerService_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutoscalerServiceServer).WatchStatus(m, &autoscalerServiceWatchStatusServer{stream})
+}
+
+type AutoscalerService_WatchStatusServer interface {
+	Send(*AutoscalerStatusUpdate) error
+	grpc.ServerStream
+}
+
+type autoscalerServiceWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *autoscalerServiceWatchStatusServer) Send(m *AutoscalerStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AutoscalerService_ServiceDesc is the grpc.ServiceDesc for AutoscalerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AutoscalerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "k9sautoscaler.proto.AutoscalerService",
+	HandlerType: (*AutoscalerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _AutoscalerService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AutoscalerService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _AutoscalerService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AutoscalerService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AutoscalerService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _AutoscalerService_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "autoscaler_service.proto",
+}
@@ -3,3 +3,4 @@
 package proto
 
 //go:generate protoc --go_out=. --go_opt=paths=source_relative --plugin=$GOPATH/bin/protoc-gen-go -I . -I ../../../../ autoscaler.proto
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative --plugin=$GOPATH/bin/protoc-gen-go --plugin=$GOPATH/bin/protoc-gen-go-grpc -I . -I ../../../../ autoscaler_service.proto
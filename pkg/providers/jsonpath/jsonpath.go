@@ -0,0 +1,75 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	k8sjsonpath "k8s.io/client-go/util/jsonpath"
+)
+
+// Parses a JSONPath expression. Enclosing braces are optional, similar to
+// kubectl.
+// See: https://kubernetes.io/docs/reference/kubectl/jsonpath/
+func Parse(name, path string) (*k8sjsonpath.JSONPath, error) {
+	parser := k8sjsonpath.New(name)
+	if err := parser.Parse(relaxedJSONPath(path)); err != nil {
+		return nil, fmt.Errorf("invalid json path %s: %v", path, err)
+	}
+
+	return parser, nil
+}
+
+// Extracts all numeric values matched by parser in data. data should be
+// decoded using json.Decoder.UseNumber(). Both quoted numbers and numbers are
+// accepted.
+func Values(parser *k8sjsonpath.JSONPath, data interface{}) ([]float64, error) {
+	results, err := parser.FindResults(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate json path: %v", err)
+	}
+
+	values := []float64{}
+	for _, result := range results {
+		for _, value := range result {
+			if value.Kind() == reflect.Interface {
+				value = value.Elem()
+			}
+			var s string
+			switch v := value.Interface().(type) {
+			case json.Number:
+				s = v.String()
+			case string:
+				s = v
+			default:
+				return nil, fmt.Errorf("json path value is not a number: %v", v)
+			}
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fmt.Errorf("json path value is not a number: %s", s)
+			}
+			values = append(values, f)
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("json path did not match any values")
+	}
+
+	return values, nil
+}
+
+// Allows json path expressions without enclosing braces, similar to kubectl.
+func relaxedJSONPath(path string) string {
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "$") {
+		path = "." + path
+	}
+	return "{" + path + "}"
+}
@@ -25,8 +25,55 @@ type aoai struct {
 type aoaiFactory struct {
 }
 
+// Defines how an AOAI metric is computed from an Azure Monitor metric.
+type aoaiMetricSpec struct {
+	metric azureMonitorMetric
+	// optional dimension to split time series by.
+	splitBy string
+	// computes the metric value from returned time series.
+	value func([]azureMonitorTimeSeriesResult) (float64, error)
+}
+
+var aoaiMetricSpecs = map[proto.AzureOAIMetricConfig_Metric]aoaiMetricSpec{
+	proto.AzureOAIMetricConfig_Percent429Rate: {
+		// status code series are summed over the same window, such that 429
+		// and total counts are of the same minutes.
+		metric:  azureMonitorMetric{name: "AzureOpenAIRequests", aggregation: proto.AzureMonitorMetricConfig_Total, reducer: proto.AzureMonitorMetricConfig_Sum},
+		splitBy: "StatusCode",
+		value:   aoaiPercent429,
+	},
+	proto.AzureOAIMetricConfig_ProvisionedManagedUtilization: {
+		metric: azureMonitorMetric{name: "AzureOpenAIProvisionedManagedUtilizationV2", aggregation: proto.AzureMonitorMetricConfig_Average},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_ProcessedPromptTokensPerMinute: {
+		metric: azureMonitorMetric{name: "ProcessedPromptTokens", aggregation: proto.AzureMonitorMetricConfig_RatePerMinute},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_GeneratedTokensPerMinute: {
+		metric: azureMonitorMetric{name: "GeneratedTokens", aggregation: proto.AzureMonitorMetricConfig_RatePerMinute},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_TimeToResponseMinuteMeanP50: {
+		metric: azureMonitorMetric{name: "AzureOpenAITimeToResponse", aggregation: proto.AzureMonitorMetricConfig_Average, reducer: proto.AzureMonitorMetricConfig_Percentile, percentile: 50},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_TimeToResponseMinuteMeanP90: {
+		metric: azureMonitorMetric{name: "AzureOpenAITimeToResponse", aggregation: proto.AzureMonitorMetricConfig_Average, reducer: proto.AzureMonitorMetricConfig_Percentile, percentile: 90},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_TimeToResponseMinuteMeanP99: {
+		metric: azureMonitorMetric{name: "AzureOpenAITimeToResponse", aggregation: proto.AzureMonitorMetricConfig_Average, reducer: proto.AzureMonitorMetricConfig_Percentile, percentile: 99},
+		value:  aoaiSingleValue,
+	},
+	proto.AzureOAIMetricConfig_ActiveTokensPerMinute: {
+		metric: azureMonitorMetric{name: "ActiveTokens", aggregation: proto.AzureMonitorMetricConfig_RatePerMinute},
+		value:  aoaiSingleValue,
+	},
+}
+
 func init() {
-	providers.RegisterMetricsClient(&proto.AzureOAIConfig{}, &aoaiFactory{})
+	providers.RegisterMetricsClient(&proto.AzureOAIConfig{}, &proto.AzureOAIMetricConfig{}, &aoaiFactory{})
 }
 
 func newAzureOAI(config *anypb.Any) (*aoai, error) {
@@ -44,7 +91,7 @@ func newAzureOAI(config *anypb.Any) (*aoai, error) {
 	}, nil
 }
 
-func (a *aoai) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]int64, time.Time, error) {
+func (a *aoai) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
 	metricConfig := proto.AzureOAIMetricConfig{}
 	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
 		return nil, time.Time{}, err
@@ -54,51 +101,75 @@ func (a *aoai) GetMetric(ctx context.Context, metricName, autoscalerName, namesp
 	if !ok {
 		return nil, time.Time{}, fmt.Errorf("unknown metric: %s", metricName)
 	}
-	// only 429 is supported
-	if metricEnumValue != int32(proto.AzureOAIMetricConfig_Percent429Rate) {
-		return nil, time.Time{}, fmt.Errorf("unsuppoerted metric: %s", metricName)
+	spec, ok := aoaiMetricSpecs[proto.AzureOAIMetricConfig_Metric(metricEnumValue)]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("unsupported metric: %s", metricName)
+	}
+
+	filter := fmt.Sprintf("ModelDeploymentName eq '%s'", metricConfig.DeploymentName)
+	if len(spec.splitBy) > 0 {
+		filter += fmt.Sprintf(" and %s eq '*'", spec.splitBy)
 	}
 	metrics := azureMonitorMetricGroup{
 		metrics: map[string]azureMonitorMetric{
-			"AzureOpenAIRequests": {
-				name:        "AzureOpenAIRequests",
-				aggregation: proto.AzureMonitorMetricConfig_Total,
-			},
+			spec.metric.name: spec.metric,
 		},
 		namespace:   "microsoft.cognitiveservices/accounts",
 		resourceURI: metricConfig.ResourceURI,
-		filter:      fmt.Sprintf("ModelDeploymentName eq '%s' and StatusCode eq '*'", metricConfig.DeploymentName),
+		filter:      filter,
+		timespan:    defaultAzureMonitorTimespan,
+		interval:    defaultAzureMonitorInterval,
 	}
-	results, timestamp, err := getMetricValues(ctx, a.metricsClient, metrics)
+	results, err := getMetricValues(ctx, a.metricsClient, metrics)
 	if err != nil {
 		return nil, time.Time{}, err
 	}
-	if len(results) != 1 {
-		return nil, time.Time{}, fmt.Errorf("expecting 1 metric, got %d", len(results))
-	}
-	timeseries, ok := results["AzureOpenAIRequests"]
+	// split metrics have no time series if there was no data in timespan.
+	result, ok := results[spec.metric.name]
 	if !ok {
-		return nil, time.Time{}, fmt.Errorf("did not get expected metric in results")
+		result = &azureMonitorMetricResult{}
+	}
+	if result.err != nil {
+		return nil, time.Time{}, result.err
 	}
-	if len(timeseries) == 1 {
-		return []int64{}, time.Time{}, nil
+	value, err := spec.value(result.timeseries)
+	if err != nil {
+		return nil, time.Time{}, err
 	}
+	timestamp := result.timestamp
+
+	klog.InfoS("aoai metric", "metric", metricName, "value", value)
 
-	total := int64(0)
-	count429 := int64(0)
+	return []float64{value}, timestamp, nil
+}
+
+func (f *aoaiFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	return newAzureOAI(config)
+}
+
+// Percentage of 429 requests out of all requests. Time series are split by
+// status code. No requests is reported as 0%.
+func aoaiPercent429(timeseries []azureMonitorTimeSeriesResult) (float64, error) {
+	total := 0.0
+	count429 := 0.0
 	for _, ts := range timeseries {
 		total += ts.value
 		if ts.dimensions["statuscode"] == "429" {
 			count429 += ts.value
 		}
 	}
-	percentage := int64(float64(100*count429) / float64(total))
-
-	klog.InfoS("aoai metric", "value", percentage)
+	if total == 0 {
+		return 0, nil
+	}
 
-	return []int64{percentage}, timestamp, nil
+	return 100 * count429 / total, nil
 }
 
-func (f *aoaiFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
-	return newAzureOAI(config)
+// Value of a metric with a single time series.
+func aoaiSingleValue(timeseries []azureMonitorTimeSeriesResult) (float64, error) {
+	if len(timeseries) != 1 {
+		return 0, fmt.Errorf("expecting 1 timeseries, got %d", len(timeseries))
+	}
+
+	return timeseries[0].value, nil
 }
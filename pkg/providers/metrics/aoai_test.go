@@ -0,0 +1,104 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/monitor/azquery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAOAIPercent429(t *testing.T) {
+	// all requests succeeded
+	value, err := aoaiPercent429([]azureMonitorTimeSeriesResult{
+		{dimensions: map[string]string{"statuscode": "200"}, value: 50},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, value)
+
+	// all requests throttled
+	value, err = aoaiPercent429([]azureMonitorTimeSeriesResult{
+		{dimensions: map[string]string{"statuscode": "429"}, value: 50},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, value)
+
+	value, err = aoaiPercent429([]azureMonitorTimeSeriesResult{
+		{dimensions: map[string]string{"statuscode": "200"}, value: 60},
+		{dimensions: map[string]string{"statuscode": "429"}, value: 30},
+		{dimensions: map[string]string{"statuscode": "500"}, value: 10},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, value)
+
+	// no requests
+	value, err = aoaiPercent429([]azureMonitorTimeSeriesResult{
+		{dimensions: map[string]string{"statuscode": "200"}, value: 0},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, value)
+	value, err = aoaiPercent429(nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, value)
+
+	// single value metrics still require data
+	_, err = aoaiSingleValue(nil)
+	require.Error(t, err)
+}
+
+func TestAOAIPercent429Window(t *testing.T) {
+	start := time.Now().Truncate(time.Minute)
+	series := func(statusCode string, values ...float64) *azquery.TimeSeriesElement {
+		data := []*azquery.MetricValue{}
+		for i, value := range values {
+			data = append(data, &azquery.MetricValue{TimeStamp: to.Ptr(start.Add(time.Duration(i) * time.Minute)), Total: to.Ptr(value)})
+		}
+		return &azquery.TimeSeriesElement{
+			MetadataValues: []*azquery.MetadataValue{{Name: &azquery.LocalizableString{Value: to.Ptr("statuscode")}, Value: to.Ptr(statusCode)}},
+			Data:           data,
+		}
+	}
+	spec := aoaiMetricSpecs[proto.AzureOAIMetricConfig_Percent429Rate]
+	group := azureMonitorMetricGroup{
+		metrics:  map[string]azureMonitorMetric{spec.metric.name: spec.metric},
+		interval: time.Minute,
+	}
+	// 429s stopped after the first minute, their latest point is older than
+	// that of other requests.
+	response := []*azquery.Metric{{
+		Name: &azquery.LocalizableString{Value: to.Ptr(spec.metric.name)},
+		TimeSeries: []*azquery.TimeSeriesElement{
+			series("200", 10, 10, 10, 10),
+			series("429", 10),
+		},
+	}}
+
+	results, err := azureMonitorResponseValues(response, group)
+	require.NoError(t, err)
+	require.NoError(t, results[spec.metric.name].err)
+	value, err := spec.value(results[spec.metric.name].timeseries)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, value)
+}
+
+func TestAOAIMetricSpecs(t *testing.T) {
+	for name, value := range proto.AzureOAIMetricConfig_Metric_value {
+		metric := proto.AzureOAIMetricConfig_Metric(value)
+		if metric == proto.AzureOAIMetricConfig_None {
+			continue
+		}
+		spec, ok := aoaiMetricSpecs[metric]
+		require.True(t, ok, name)
+		_, err := newAzureMonitorMetric(spec.metric.name, &proto.AzureMonitorMetricConfig{
+			Aggregation: spec.metric.aggregation,
+			Reducer:     spec.metric.reducer,
+			Percentile:  spec.metric.percentile,
+		})
+		require.NoError(t, err, name)
+	}
+}
@@ -5,6 +5,8 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,8 +22,22 @@ import (
 	"k8s.io/klog/v2"
 )
 
+const (
+	defaultAzureMonitorTimespan = 10 * time.Minute
+	defaultAzureMonitorInterval = time.Minute
+)
+
+var (
+	_ metricstypes.BatchMetricsClient = &azureMonitor{}
+)
+
 type azureMonitor struct {
-	metricsClient *azquery.MetricsClient
+	metricsClient azureMonitorQueryClient
+}
+
+// Subset of azquery.MetricsClient used to query metrics.
+type azureMonitorQueryClient interface {
+	QueryResource(ctx context.Context, resourceURI string, options *azquery.MetricsClientQueryResourceOptions) (azquery.MetricsClientQueryResourceResponse, error)
 }
 
 type azureMonitorFactory struct {
@@ -30,20 +46,32 @@ type azureMonitorFactory struct {
 type azureMonitorMetric struct {
 	name        string
 	aggregation proto.AzureMonitorMetricConfig_Aggregation
+	reducer     proto.AzureMonitorMetricConfig_Reducer
+	percentile  float64
 }
 type azureMonitorMetricGroup struct {
 	metrics     map[string]azureMonitorMetric
 	namespace   string
 	resourceURI string
 	filter      string
+	timespan    time.Duration
+	interval    time.Duration
 }
 type azureMonitorTimeSeriesResult struct {
 	dimensions map[string]string
-	value      int64
+	value      float64
+}
+
+// Time series of a single metric in a query response, or the error of
+// reducing them.
+type azureMonitorMetricResult struct {
+	timeseries []azureMonitorTimeSeriesResult
+	timestamp  time.Time
+	err        error
 }
 
 func init() {
-	providers.RegisterMetricsClient(&proto.AzureMonitorConfig{}, &azureMonitorFactory{})
+	providers.RegisterMetricsClient(&proto.AzureMonitorConfig{}, &proto.AzureMonitorMetricConfig{}, &azureMonitorFactory{})
 }
 
 func newAzureMonitor() (*azureMonitor, error) {
@@ -61,56 +89,184 @@ func newAzureMonitor() (*azureMonitor, error) {
 	}, nil
 }
 
-func (am *azureMonitor) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]int64, time.Time, error) {
+func (am *azureMonitor) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
 	metricConfig := proto.AzureMonitorMetricConfig{}
 	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
 		return nil, time.Time{}, err
 	}
 
-	metrics := azureMonitorMetricGroup{
-		metrics: map[string]azureMonitorMetric{
-			metricName: {
-				name:        metricName,
-				aggregation: metricConfig.Aggregation,
-			},
-		},
-		namespace:   metricConfig.MetricNamespace,
-		resourceURI: metricConfig.ResourceURI,
-	}
-	if metricConfig.Filter != nil {
-		metrics.filter = *metricConfig.Filter
+	metric, err := newAzureMonitorMetric(metricName, &metricConfig)
+	if err != nil {
+		return nil, time.Time{}, err
 	}
+	metrics := newAzureMonitorMetricGroup(&metricConfig)
+	metrics.metrics[metricName] = metric
 
-	results, timestamp, err := getMetricValues(ctx, am.metricsClient, metrics)
+	results, err := getMetricValues(ctx, am.metricsClient, metrics)
 	if err != nil {
 		return nil, time.Time{}, err
 	}
-	if len(results) != 1 {
-		return nil, time.Time{}, fmt.Errorf("expecting 1 metric, got %d", len(results))
-	}
-	timeseries, ok := results[metricName]
-	if !ok {
-		return nil, time.Time{}, fmt.Errorf("did not get expected metric in results")
+	values, timestamp, err := azureMonitorValues(results, metricName, metricConfig.Combine)
+	if err != nil {
+		return nil, time.Time{}, err
 	}
-	if len(timeseries) != 1 {
-		return nil, time.Time{}, fmt.Errorf("expecting 1 timeseries, got %d", len(timeseries))
+
+	klog.InfoS("azure monitor metrics", "values", values, "timestamp", timestamp)
+
+	return values, timestamp, nil
+}
+
+// Gets values of multiple metrics. Requests for the same resource, metric
+// namespace, filter, timespan and interval are grouped and fetched in a single query.
+// A metric that fails in a query only fails requests of that metric.
+// Implements BatchMetricsClient.
+func (am *azureMonitor) GetMetrics(ctx context.Context, requests []*metricstypes.MetricRequest) ([]*metricstypes.MetricResult, error) {
+	results := make([]*metricstypes.MetricResult, len(requests))
+	groups := []*azureMonitorMetricGroup{}
+	requestIndexesByGroup := make(map[*azureMonitorMetricGroup][]int)
+	combines := make([]proto.AzureMonitorCombine, len(requests))
+	for i, request := range requests {
+		metricConfig := proto.AzureMonitorMetricConfig{}
+		if err := anypb.UnmarshalTo(request.Config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+			results[i] = &metricstypes.MetricResult{Err: err}
+			continue
+		}
+		metric, err := newAzureMonitorMetric(request.MetricName, &metricConfig)
+		if err != nil {
+			results[i] = &metricstypes.MetricResult{Err: err}
+			continue
+		}
+		combines[i] = metricConfig.Combine
+		candidate := newAzureMonitorMetricGroup(&metricConfig)
+
+		var group *azureMonitorMetricGroup
+		for _, g := range groups {
+			if g.resourceURI != candidate.resourceURI || g.namespace != candidate.namespace || g.filter != candidate.filter ||
+				g.timespan != candidate.timespan || g.interval != candidate.interval {
+				continue
+			}
+			// same metric name can only be requested once per query.
+			if existing, ok := g.metrics[metric.name]; ok && existing != metric {
+				continue
+			}
+			group = g
+			break
+		}
+		if group == nil {
+			group = &candidate
+			groups = append(groups, group)
+		}
+		group.metrics[metric.name] = metric
+		requestIndexesByGroup[group] = append(requestIndexesByGroup[group], i)
 	}
 
-	klog.InfoS("azure monitor metrics", "values", results, "timestamp", timestamp)
+	for _, group := range groups {
+		values, err := getMetricValues(ctx, am.metricsClient, *group)
+		for _, i := range requestIndexesByGroup[group] {
+			result := &metricstypes.MetricResult{Err: err}
+			if err == nil {
+				result.Values, result.Timestamp, result.Err = azureMonitorValues(values, requests[i].MetricName, combines[i])
+			}
+			results[i] = result
+		}
+		klog.V(4).InfoS("azure monitor batch metrics", "resourceURI", group.resourceURI, "metrics", len(group.metrics), "requests", len(requestIndexesByGroup[group]), "error", err)
+	}
 
-	return []int64{timeseries[0].value}, timestamp, nil
+	return results, nil
 }
 
 func (f *azureMonitorFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
 	return newAzureMonitor()
 }
 
-func getMetricValues(ctx context.Context, metricsClient *azquery.MetricsClient, metrics azureMonitorMetricGroup) (map[string][]azureMonitorTimeSeriesResult, time.Time, error) {
+func newAzureMonitorMetric(metricName string, metricConfig *proto.AzureMonitorMetricConfig) (azureMonitorMetric, error) {
+	if metricConfig.Reducer == proto.AzureMonitorMetricConfig_Percentile &&
+		(metricConfig.Percentile <= 0 || metricConfig.Percentile > 100) {
+		return azureMonitorMetric{}, fmt.Errorf("percentile must be in (0, 100], got %v", metricConfig.Percentile)
+	}
+
+	return azureMonitorMetric{
+		name:        metricName,
+		aggregation: metricConfig.Aggregation,
+		reducer:     metricConfig.Reducer,
+		percentile:  metricConfig.Percentile,
+	}, nil
+}
+
+func newAzureMonitorMetricGroup(metricConfig *proto.AzureMonitorMetricConfig) azureMonitorMetricGroup {
+	group := azureMonitorMetricGroup{
+		metrics:     make(map[string]azureMonitorMetric),
+		namespace:   metricConfig.MetricNamespace,
+		resourceURI: metricConfig.ResourceURI,
+		timespan:    defaultAzureMonitorTimespan,
+		interval:    defaultAzureMonitorInterval,
+	}
+	if metricConfig.Filter != nil {
+		group.filter = *metricConfig.Filter
+	}
+	if metricConfig.Timespan != nil {
+		group.timespan = metricConfig.Timespan.AsDuration()
+	}
+	if metricConfig.Interval != nil {
+		group.interval = metricConfig.Interval.AsDuration()
+	}
+
+	return group
+}
+
+// Returns values of metricName from results, one per time series or combined
+// into a single value, and their latest timestamp.
+func azureMonitorValues(results map[string]*azureMonitorMetricResult, metricName string, combine proto.AzureMonitorCombine) ([]float64, time.Time, error) {
+	result, ok := results[metricName]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("did not get expected metric %s in results", metricName)
+	}
+	if result.err != nil {
+		return nil, time.Time{}, result.err
+	}
+	timeseries := result.timeseries
+	if len(timeseries) == 0 {
+		return nil, time.Time{}, fmt.Errorf("no timeseries returned for %s", metricName)
+	}
+
+	values := make([]float64, len(timeseries))
+	for i, ts := range timeseries {
+		values[i] = ts.value
+	}
+	switch combine {
+	case proto.AzureMonitorCombine_PerTimeSeries:
+		return values, result.timestamp, nil
+	case proto.AzureMonitorCombine_Sum, proto.AzureMonitorCombine_Average:
+		sum := 0.0
+		for _, value := range values {
+			sum += value
+		}
+		if combine == proto.AzureMonitorCombine_Average {
+			sum /= float64(len(values))
+		}
+		return []float64{sum}, result.timestamp, nil
+	case proto.AzureMonitorCombine_Maximum:
+		max := values[0]
+		for _, value := range values[1:] {
+			if value > max {
+				max = value
+			}
+		}
+		return []float64{max}, result.timestamp, nil
+	default:
+		return nil, time.Time{}, fmt.Errorf("unknown combine type: %v", combine)
+	}
+}
+
+func getMetricValues(ctx context.Context, metricsClient azureMonitorQueryClient, metrics azureMonitorMetricGroup) (map[string]*azureMonitorMetricResult, error) {
 	if len(metrics.resourceURI) == 0 {
-		return nil, time.Time{}, fmt.Errorf("resourceURI is required")
+		return nil, fmt.Errorf("resourceURI is required")
 	}
 	if len(metrics.namespace) == 0 {
-		return nil, time.Time{}, fmt.Errorf("namespace is required")
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if metrics.interval <= 0 || metrics.timespan < metrics.interval {
+		return nil, fmt.Errorf("interval must be > 0 and <= timespan, got interval %v timespan %v", metrics.interval, metrics.timespan)
 	}
 
 	metricNames := []string{}
@@ -118,10 +274,11 @@ func getMetricValues(ctx context.Context, metricsClient *azquery.MetricsClient,
 		metricNames = append(metricNames, name)
 	}
 
+	now := time.Now()
 	options := azquery.MetricsClientQueryResourceOptions{
 		MetricNames: to.Ptr(strings.Join(metricNames, ",")),
-		Interval:    to.Ptr("PT1M"),
-		Timespan:    to.Ptr(azquery.NewTimeInterval(time.Now().Add(-10*time.Minute), time.Now())),
+		Interval:    to.Ptr(iso8601Duration(metrics.interval)),
+		Timespan:    to.Ptr(azquery.NewTimeInterval(now.Add(-metrics.timespan), now)),
 	}
 
 	options.MetricNamespace = &metrics.namespace
@@ -134,59 +291,163 @@ func getMetricValues(ctx context.Context, metricsClient *azquery.MetricsClient,
 		metrics.resourceURI,
 		&options)
 	if err != nil {
-		return nil, time.Time{}, err
-	}
-	if len(response.Value) != len(metrics.metrics) {
-		return nil, time.Now(), fmt.Errorf("mismatched number of returned metrics: %d != %d", len(metrics.metrics), len(response.Value))
+		return nil, err
 	}
 
-	metricsValues := map[string][]azureMonitorTimeSeriesResult{}
-	// TODO: this assumes that latest is same across all returned metrics
-	latestTimestamp := time.Time{}
-	for _, value := range response.Value {
+	return azureMonitorResponseValues(response.Value, metrics)
+}
+
+// Reduces data points of each returned time series into a single value.
+// Timestamp of each metric is the latest timestamp of data points with values.
+// Failing to reduce a time series fails only its metric.
+func azureMonitorResponseValues(response []*azquery.Metric, metrics azureMonitorMetricGroup) (map[string]*azureMonitorMetricResult, error) {
+	metricsValues := map[string]*azureMonitorMetricResult{}
+	for _, value := range response {
 		incomingName := *value.Name.Value
+		metric, ok := metrics.metrics[incomingName]
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric in results: %s", incomingName)
+		}
+		result := &azureMonitorMetricResult{}
+		metricsValues[incomingName] = result
 		for _, ts := range value.TimeSeries {
-			timeSeriesResult := azureMonitorTimeSeriesResult{dimensions: make(map[string]string)}
-			for _, dim := range ts.MetadataValues {
-				timeSeriesResult.dimensions[*dim.Name.Value] = *dim.Value
-			}
-
-			values := ts.Data
-			if len(values) == 0 {
-				return nil, time.Now(), fmt.Errorf("no timeseries data for %s", incomingName)
-			}
-			latest := values[len(values)-1]
-
-			var metricValue *float64
-			switch metrics.metrics[incomingName].aggregation {
-			case proto.AzureMonitorMetricConfig_None:
-				return nil, time.Time{}, fmt.Errorf("aggregation type is required")
-			case proto.AzureMonitorMetricConfig_Average:
-				metricValue = latest.Average
-			case proto.AzureMonitorMetricConfig_Count:
-				metricValue = latest.Count
-			case proto.AzureMonitorMetricConfig_Maximum:
-				metricValue = latest.Maximum
-			case proto.AzureMonitorMetricConfig_Minimum:
-				metricValue = latest.Minimum
-			case proto.AzureMonitorMetricConfig_Total:
-				metricValue = latest.Total
-			case proto.AzureMonitorMetricConfig_RatePerMinute:
-				if latest.Total == nil {
-					return nil, time.Time{}, fmt.Errorf("cannot calculate rate: metric does not support Total")
-				}
-				metricValue = latest.Total
-			default:
-				return nil, time.Time{}, fmt.Errorf("unknown aggregation type: %v", metrics.metrics[incomingName].aggregation)
+			timeSeriesResult, timestamp, err := reduceAzureMonitorTimeSeries(ts, metric, metrics.interval)
+			if err != nil {
+				result.err = err
+				break
 			}
-			if metricValue == nil {
-				return nil, time.Time{}, fmt.Errorf("specified aggregation type %v is not supported by metric", metrics.metrics[incomingName].aggregation.String())
+			if timestamp.After(result.timestamp) {
+				result.timestamp = timestamp
 			}
-			latestTimestamp = *latest.TimeStamp
-			timeSeriesResult.value = int64(*metricValue)
-			metricsValues[incomingName] = append(metricsValues[incomingName], timeSeriesResult)
+			result.timeseries = append(result.timeseries, timeSeriesResult)
+		}
+	}
+
+	return metricsValues, nil
+}
+
+// Reduces data points of ts into a single value. Returned timestamp is the
+// latest timestamp of data points with values.
+func reduceAzureMonitorTimeSeries(ts *azquery.TimeSeriesElement, metric azureMonitorMetric, interval time.Duration) (azureMonitorTimeSeriesResult, time.Time, error) {
+	timeSeriesResult := azureMonitorTimeSeriesResult{dimensions: make(map[string]string)}
+	for _, dim := range ts.MetadataValues {
+		timeSeriesResult.dimensions[*dim.Name.Value] = *dim.Value
+	}
+
+	points := []float64{}
+	timestamp := time.Time{}
+	for _, point := range ts.Data {
+		pointValue, err := azureMonitorPointValue(point, metric.aggregation)
+		if err != nil {
+			return azureMonitorTimeSeriesResult{}, time.Time{}, err
+		}
+		if pointValue == nil {
+			continue
+		}
+		if metric.aggregation == proto.AzureMonitorMetricConfig_RatePerMinute {
+			*pointValue = *pointValue / interval.Minutes()
+		}
+		points = append(points, *pointValue)
+		if point.TimeStamp != nil && point.TimeStamp.After(timestamp) {
+			timestamp = *point.TimeStamp
+		}
+	}
+	if len(points) == 0 {
+		return azureMonitorTimeSeriesResult{}, time.Time{}, fmt.Errorf("no %v data points for %s, aggregation may not be supported by metric", metric.aggregation.String(), metric.name)
+	}
+	timeSeriesResult.value = reduceAzureMonitorPoints(points, metric.reducer, metric.percentile)
+
+	return timeSeriesResult, timestamp, nil
+}
+
+// Returns the value of a single data point for aggregation, or nil if the
+// data point has no such value.
+func azureMonitorPointValue(point *azquery.MetricValue, aggregation proto.AzureMonitorMetricConfig_Aggregation) (*float64, error) {
+	var value *float64
+	switch aggregation {
+	case proto.AzureMonitorMetricConfig_None:
+		return nil, fmt.Errorf("aggregation type is required")
+	case proto.AzureMonitorMetricConfig_Average:
+		value = point.Average
+	case proto.AzureMonitorMetricConfig_Count:
+		value = point.Count
+	case proto.AzureMonitorMetricConfig_Maximum:
+		value = point.Maximum
+	case proto.AzureMonitorMetricConfig_Minimum:
+		value = point.Minimum
+	case proto.AzureMonitorMetricConfig_Total, proto.AzureMonitorMetricConfig_RatePerMinute:
+		value = point.Total
+	default:
+		return nil, fmt.Errorf("unknown aggregation type: %v", aggregation)
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	return to.Ptr(*value), nil
+}
+
+// Reduces non-empty points into a single value. Points are in time order.
+func reduceAzureMonitorPoints(points []float64, reducer proto.AzureMonitorMetricConfig_Reducer, percentile float64) float64 {
+	switch reducer {
+	case proto.AzureMonitorMetricConfig_Mean:
+		sum := 0.0
+		for _, point := range points {
+			sum += point
+		}
+		return sum / float64(len(points))
+	case proto.AzureMonitorMetricConfig_Sum:
+		sum := 0.0
+		for _, point := range points {
+			sum += point
+		}
+		return sum
+	case proto.AzureMonitorMetricConfig_Max:
+		max := points[0]
+		for _, point := range points[1:] {
+			max = math.Max(max, point)
+		}
+		return max
+	case proto.AzureMonitorMetricConfig_Percentile:
+		sorted := append([]float64{}, points...)
+		sort.Float64s(sorted)
+		rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	default:
+		return points[len(points)-1]
+	}
+}
+
+// Formats d as an ISO 8601 duration as expected by Azure Monitor, for example
+// PT1M or P1D.
+func iso8601Duration(d time.Duration) string {
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+
+	result := "P"
+	if days > 0 {
+		result += fmt.Sprintf("%dD", days)
+	}
+	if hours > 0 || minutes > 0 || seconds > 0 {
+		result += "T"
+		if hours > 0 {
+			result += fmt.Sprintf("%dH", hours)
+		}
+		if minutes > 0 {
+			result += fmt.Sprintf("%dM", minutes)
+		}
+		if seconds > 0 {
+			result += fmt.Sprintf("%dS", seconds)
 		}
 	}
 
-	return metricsValues, latestTimestamp, nil
+	return result
 }
@@ -0,0 +1,187 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/monitor/azquery"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestAzureMonitorResponseValues(t *testing.T) {
+	start := time.Now().Truncate(time.Minute)
+	// last point is not yet populated, as commonly returned by azure monitor.
+	response := func() []*azquery.Metric {
+		data := []*azquery.MetricValue{}
+		for i, value := range []float64{40, 10, 30, 20} {
+			data = append(data, &azquery.MetricValue{TimeStamp: to.Ptr(start.Add(time.Duration(i) * time.Minute)), Average: to.Ptr(value), Total: to.Ptr(value * 5)})
+		}
+		data = append(data, &azquery.MetricValue{TimeStamp: to.Ptr(start.Add(4 * time.Minute))})
+		return []*azquery.Metric{{
+			Name:       &azquery.LocalizableString{Value: to.Ptr("testmetric")},
+			TimeSeries: []*azquery.TimeSeriesElement{{Data: data}},
+		}}
+	}
+
+	testCases := []struct {
+		name     string
+		config   *proto.AzureMonitorMetricConfig
+		expected float64
+	}{
+		{"latest", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average}, 20},
+		{"mean", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average, Reducer: proto.AzureMonitorMetricConfig_Mean}, 25},
+		{"max", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average, Reducer: proto.AzureMonitorMetricConfig_Max}, 40},
+		{"sum", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average, Reducer: proto.AzureMonitorMetricConfig_Sum}, 100},
+		{"p50", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average, Reducer: proto.AzureMonitorMetricConfig_Percentile, Percentile: 50}, 20},
+		{"p90", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Average, Reducer: proto.AzureMonitorMetricConfig_Percentile, Percentile: 90}, 40},
+		{"total", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Total}, 100},
+		{"rate", &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_RatePerMinute, Interval: durationpb.New(5 * time.Minute)}, 20},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metric, err := newAzureMonitorMetric("testmetric", tc.config)
+			require.NoError(t, err)
+			group := newAzureMonitorMetricGroup(tc.config)
+			group.metrics[metric.name] = metric
+
+			results, err := azureMonitorResponseValues(response(), group)
+			require.NoError(t, err)
+			values, timestamp, err := azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_PerTimeSeries)
+			require.NoError(t, err)
+			require.Equal(t, start.Add(3*time.Minute), timestamp)
+			require.Equal(t, []float64{tc.expected}, values)
+		})
+	}
+
+	// aggregation not supported by metric
+	config := &proto.AzureMonitorMetricConfig{Aggregation: proto.AzureMonitorMetricConfig_Maximum}
+	metric, err := newAzureMonitorMetric("testmetric", config)
+	require.NoError(t, err)
+	group := newAzureMonitorMetricGroup(config)
+	group.metrics[metric.name] = metric
+	results, err := azureMonitorResponseValues(response(), group)
+	require.NoError(t, err)
+	_, _, err = azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_PerTimeSeries)
+	require.Error(t, err)
+
+	// invalid percentile
+	_, err = newAzureMonitorMetric("testmetric", &proto.AzureMonitorMetricConfig{Reducer: proto.AzureMonitorMetricConfig_Percentile})
+	require.Error(t, err)
+}
+
+func TestAzureMonitorValues(t *testing.T) {
+	timestamp := time.Now()
+	results := map[string]*azureMonitorMetricResult{
+		"testmetric": {
+			timeseries: []azureMonitorTimeSeriesResult{
+				{dimensions: map[string]string{"node": "node1"}, value: 30},
+				{dimensions: map[string]string{"node": "node2"}, value: 10},
+				{dimensions: map[string]string{"node": "node3"}, value: 20},
+			},
+			timestamp: timestamp,
+		},
+		"empty":  {},
+		"failed": {err: fmt.Errorf("test error")},
+	}
+
+	values, valuesTimestamp, err := azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_PerTimeSeries)
+	require.NoError(t, err)
+	require.Equal(t, timestamp, valuesTimestamp)
+	require.Equal(t, []float64{30, 10, 20}, values)
+	values, _, err = azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_Sum)
+	require.NoError(t, err)
+	require.Equal(t, []float64{60}, values)
+	values, _, err = azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_Average)
+	require.NoError(t, err)
+	require.Equal(t, []float64{20}, values)
+	values, _, err = azureMonitorValues(results, "testmetric", proto.AzureMonitorCombine_Maximum)
+	require.NoError(t, err)
+	require.Equal(t, []float64{30}, values)
+
+	_, _, err = azureMonitorValues(results, "empty", proto.AzureMonitorCombine_Sum)
+	require.Error(t, err)
+	_, _, err = azureMonitorValues(results, "failed", proto.AzureMonitorCombine_Sum)
+	require.Error(t, err)
+	_, _, err = azureMonitorValues(results, "none", proto.AzureMonitorCombine_Sum)
+	require.Error(t, err)
+}
+
+// Returns queried metrics with a single data point of their configured
+// value, or no data points if the value is missing.
+type testAzureMonitorQueryClient struct {
+	values  map[string]float64
+	queries []string
+}
+
+func (c *testAzureMonitorQueryClient) QueryResource(ctx context.Context, resourceURI string, options *azquery.MetricsClientQueryResourceOptions) (azquery.MetricsClientQueryResourceResponse, error) {
+	c.queries = append(c.queries, resourceURI+":"+*options.MetricNames)
+	if resourceURI == "failed" {
+		return azquery.MetricsClientQueryResourceResponse{}, fmt.Errorf("test error")
+	}
+	response := azquery.MetricsClientQueryResourceResponse{}
+	for _, name := range strings.Split(*options.MetricNames, ",") {
+		data := []*azquery.MetricValue{{TimeStamp: to.Ptr(time.Now())}}
+		if value, ok := c.values[name]; ok {
+			data[0].Average = to.Ptr(value)
+		}
+		response.Value = append(response.Value, &azquery.Metric{
+			Name:       &azquery.LocalizableString{Value: to.Ptr(name)},
+			TimeSeries: []*azquery.TimeSeriesElement{{Data: data}},
+		})
+	}
+	return response, nil
+}
+
+func TestAzureMonitorGetMetrics(t *testing.T) {
+	queryClient := &testAzureMonitorQueryClient{values: map[string]float64{"metric1": 1, "metric2": 2}}
+	am := &azureMonitor{metricsClient: queryClient}
+	request := func(metricName, resourceURI string) *metricstypes.MetricRequest {
+		config, err := anypb.New(&proto.AzureMonitorMetricConfig{
+			ResourceURI:     resourceURI,
+			MetricNamespace: "testns",
+			Aggregation:     proto.AzureMonitorMetricConfig_Average,
+		})
+		require.NoError(t, err)
+		return &metricstypes.MetricRequest{MetricName: metricName, Config: config}
+	}
+
+	// metric3 has no data points, which only fails its own request.
+	results, err := am.GetMetrics(context.Background(), []*metricstypes.MetricRequest{
+		request("metric1", "resource1"),
+		request("metric2", "resource1"),
+		request("metric3", "resource1"),
+		request("metric1", "resource2"),
+		request("metric1", "failed"),
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	require.Len(t, queryClient.queries, 3)
+
+	require.NoError(t, results[0].Err)
+	require.Equal(t, []float64{1}, results[0].Values)
+	require.NoError(t, results[1].Err)
+	require.Equal(t, []float64{2}, results[1].Values)
+	require.Error(t, results[2].Err)
+	require.NoError(t, results[3].Err)
+	require.Equal(t, []float64{1}, results[3].Values)
+	require.Error(t, results[4].Err)
+}
+
+func TestISO8601Duration(t *testing.T) {
+	require.Equal(t, "PT1M", iso8601Duration(time.Minute))
+	require.Equal(t, "PT1H30M", iso8601Duration(90*time.Minute))
+	require.Equal(t, "PT30S", iso8601Duration(30*time.Second))
+	require.Equal(t, "P1D", iso8601Duration(24*time.Hour))
+	require.Equal(t, "P1DT6H", iso8601Duration(30*time.Hour))
+}
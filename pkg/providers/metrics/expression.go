@@ -0,0 +1,239 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"golang.org/x/sync/errgroup"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+)
+
+// Expression metrics provider adapter. Each metric is computed from an
+// arithmetic expression over variables that are read from other configured
+// metrics providers.
+// see: pkg/providers/metrics/proto/expression.proto
+type expression struct{}
+
+type expressionFactory struct{}
+
+func init() {
+	providers.RegisterMetricsClient(&proto.ExpressionConfig{}, &proto.ExpressionMetricConfig{}, &expressionFactory{})
+}
+
+func (f *expressionFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	expressionConfig := proto.ExpressionConfig{}
+	if err := anypb.UnmarshalTo(config, &expressionConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &expression{}, nil
+}
+
+// Expressions are autoscaler scoped if any of their variables is.
+func (e *expression) AutoscalerScoped(config *anypb.Any) bool {
+	metricConfig := proto.ExpressionMetricConfig{}
+	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+		return true
+	}
+	for _, variable := range metricConfig.Variables {
+		client, err := providers.MetricsClientByMetricConfig(variable.Config)
+		if err != nil {
+			return true
+		}
+		if scopedClient, ok := client.(metricstypes.AutoscalerScopedMetricsClient); ok && scopedClient.AutoscalerScoped(variable.Config) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *expression) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	metricConfig := proto.ExpressionMetricConfig{}
+	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, time.Time{}, err
+	}
+	expr, err := parser.ParseExpr(metricConfig.Expression)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid expression %s: %v", metricConfig.Expression, err)
+	}
+
+	lock := sync.Mutex{}
+	variables := make(map[string]float64)
+	timestamp := time.Time{}
+	group, groupCtx := errgroup.WithContext(ctx)
+	for name, variable := range metricConfig.Variables {
+		name, variable := name, variable
+		group.Go(func() error {
+			value, variableTimestamp, err := getExpressionVariable(groupCtx, name, variable, autoscalerName, namespace)
+			if err != nil {
+				return err
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			variables[name] = value
+			// result is as old as its oldest variable
+			if timestamp.IsZero() || (!variableTimestamp.IsZero() && variableTimestamp.Before(timestamp)) {
+				timestamp = variableTimestamp
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	value, err := evalExpression(expr, variables)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to evaluate expression %s: %v", metricConfig.Expression, err)
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, time.Time{}, fmt.Errorf("expression %s result is not a number: %v", metricConfig.Expression, value)
+	}
+
+	klog.V(4).InfoS("expression metric", "metric", metricName, "variables", variables, "value", value)
+
+	return []float64{value}, timestamp, nil
+}
+
+// Reads values of a variable from its provider and returns their sum.
+func getExpressionVariable(ctx context.Context, name string, variable *proto.ExpressionVariable, autoscalerName, namespace string) (float64, time.Time, error) {
+	metricName := variable.MetricName
+	if len(metricName) == 0 {
+		metricName = name
+	}
+	client, err := providers.MetricsClientByMetricConfig(variable.Config)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("variable %s: %v", name, err)
+	}
+	values, timestamp, err := client.GetMetric(ctx, metricName, autoscalerName, namespace, variable.Config)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to get variable %s: %v", name, err)
+	}
+	if len(values) == 0 {
+		return 0, time.Time{}, fmt.Errorf("variable %s has no values", name)
+	}
+
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum, timestamp, nil
+}
+
+// Evaluates an arithmetic expression using variables.
+func evalExpression(expr ast.Expr, variables map[string]float64) (float64, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT {
+			return 0, fmt.Errorf("unsupported literal %s", e.Value)
+		}
+		return strconv.ParseFloat(e.Value, 64)
+	case *ast.Ident:
+		value, ok := variables[e.Name]
+		if !ok {
+			return 0, fmt.Errorf("unknown variable %s", e.Name)
+		}
+		return value, nil
+	case *ast.ParenExpr:
+		return evalExpression(e.X, variables)
+	case *ast.UnaryExpr:
+		x, err := evalExpression(e.X, variables)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x, nil
+		case token.SUB:
+			return -x, nil
+		}
+		return 0, fmt.Errorf("unsupported operator %s", e.Op)
+	case *ast.BinaryExpr:
+		x, err := evalExpression(e.X, variables)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalExpression(e.Y, variables)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x + y, nil
+		case token.SUB:
+			return x - y, nil
+		case token.MUL:
+			return x * y, nil
+		case token.QUO:
+			// 0 / 0 is 0, such that ratios like errors / requests do not
+			// fail metrics while there is no traffic.
+			if y == 0 {
+				if x == 0 {
+					return 0, nil
+				}
+				return 0, fmt.Errorf("division by zero")
+			}
+			return x / y, nil
+		}
+		return 0, fmt.Errorf("unsupported operator %s", e.Op)
+	case *ast.CallExpr:
+		return evalExpressionCall(e, variables)
+	default:
+		return 0, fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+func evalExpressionCall(call *ast.CallExpr, variables map[string]float64) (float64, error) {
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return 0, fmt.Errorf("unsupported function call")
+	}
+	args := make([]float64, len(call.Args))
+	for i, arg := range call.Args {
+		value, err := evalExpression(arg, variables)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = value
+	}
+
+	switch fun.Name {
+	case "min", "max":
+		if len(args) == 0 {
+			return 0, fmt.Errorf("%s requires at least one argument", fun.Name)
+		}
+		result := args[0]
+		for _, arg := range args[1:] {
+			if fun.Name == "min" {
+				result = math.Min(result, arg)
+			} else {
+				result = math.Max(result, arg)
+			}
+		}
+		return result, nil
+	case "abs":
+		if len(args) != 1 {
+			return 0, fmt.Errorf("abs requires one argument")
+		}
+		return math.Abs(args[0]), nil
+	default:
+		return 0, fmt.Errorf("unknown function %s", fun.Name)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"go/parser"
+	"testing"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+	configproto "k9s-autoscaler/pkg/providers/proto"
+
+	"github.com/stretchr/testify/require"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	testConstantTimestamp = time.Now()
+)
+
+// A metrics provider that returns the value in its metric config. Metrics
+// named "multi" return the value twice, and "failed" return an error.
+type testConstantMetrics struct{}
+
+func (c *testConstantMetrics) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	return c, nil
+}
+
+func (c *testConstantMetrics) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	value := wrapperspb.DoubleValue{}
+	if err := anypb.UnmarshalTo(config, &value, protob.UnmarshalOptions{}); err != nil {
+		return nil, time.Time{}, err
+	}
+	switch metricName {
+	case "multi":
+		return []float64{value.Value, value.Value}, testConstantTimestamp, nil
+	case "failed":
+		return nil, time.Time{}, fmt.Errorf("test error")
+	}
+	return []float64{value.Value}, testConstantTimestamp, nil
+}
+
+func TestExpressionGetMetric(t *testing.T) {
+	providers.RegisterMetricsClient(&wrapperspb.StringValue{}, &wrapperspb.DoubleValue{}, &testConstantMetrics{})
+	_, err := providers.MetricsClient(&configproto.ProviderConfig{Config: mustAny(t, &wrapperspb.StringValue{})})
+	require.NoError(t, err)
+
+	client, err := (&expressionFactory{}).MetricsClient(mustAny(t, &proto.ExpressionConfig{}))
+	require.NoError(t, err)
+	variable := func(metricName string, value float64) *proto.ExpressionVariable {
+		return &proto.ExpressionVariable{MetricName: metricName, Config: mustAny(t, wrapperspb.Double(value))}
+	}
+	getMetric := func(expression string, variables map[string]*proto.ExpressionVariable) (float64, error) {
+		values, timestamp, err := client.GetMetric(
+			context.Background(),
+			"testmetric",
+			"testas",
+			"testns",
+			mustAny(t, &proto.ExpressionMetricConfig{Expression: expression, Variables: variables}))
+		if err != nil {
+			return 0, err
+		}
+		require.Len(t, values, 1)
+		require.Equal(t, testConstantTimestamp, timestamp)
+		return values[0], nil
+	}
+
+	value, err := getMetric("errors / requests * 100", map[string]*proto.ExpressionVariable{
+		"errors":   variable("", 5),
+		"requests": variable("", 20),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 25.0, value)
+
+	value, err = getMetric("max(a, b) - min(a, b, 1.5) + abs(-a)", map[string]*proto.ExpressionVariable{
+		"a": variable("", 2),
+		"b": variable("", 3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3.5, value)
+
+	// multiple values are summed
+	value, err = getMetric("a", map[string]*proto.ExpressionVariable{
+		"a": variable("multi", 2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4.0, value)
+
+	// errors
+	for _, tc := range []struct {
+		expression string
+		variables  map[string]*proto.ExpressionVariable
+	}{
+		{"a / b", map[string]*proto.ExpressionVariable{"a": variable("", 1), "b": variable("", 0)}},
+		{"a + c", map[string]*proto.ExpressionVariable{"a": variable("", 1)}},
+		{"a +", map[string]*proto.ExpressionVariable{"a": variable("", 1)}},
+		{"a", map[string]*proto.ExpressionVariable{"a": variable("failed", 1)}},
+		{"a", map[string]*proto.ExpressionVariable{"a": {Config: mustAny(t, wrapperspb.Float(1))}}},
+		{"sqrt(a)", map[string]*proto.ExpressionVariable{"a": variable("", 1)}},
+		{"a == 1", map[string]*proto.ExpressionVariable{"a": variable("", 1)}},
+		{"a.b", map[string]*proto.ExpressionVariable{"a": variable("", 1)}},
+		{`"a"`, nil},
+		{"max()", nil},
+		{"abs(1, 2)", nil},
+	} {
+		_, err := getMetric(tc.expression, tc.variables)
+		require.Error(t, err, tc.expression)
+	}
+
+	// expressions are autoscaler scoped if any variable is
+	_, err = providers.MetricsClient(&configproto.ProviderConfig{Config: mustAny(t, &proto.HTTPJSONConfig{})})
+	require.NoError(t, err)
+	e := &expression{}
+	require.False(t, e.AutoscalerScoped(mustAny(t, &proto.ExpressionMetricConfig{
+		Expression: "a",
+		Variables:  map[string]*proto.ExpressionVariable{"a": {Config: mustAny(t, wrapperspb.Double(1))}},
+	})))
+	require.True(t, e.AutoscalerScoped(mustAny(t, &proto.ExpressionMetricConfig{
+		Expression: "a + b",
+		Variables: map[string]*proto.ExpressionVariable{
+			"a": {Config: mustAny(t, wrapperspb.Double(1))},
+			"b": {Config: mustAny(t, &proto.HTTPJSONMetricConfig{Url: "http://myservice/{autoscalerName}"})},
+		},
+	})))
+}
+
+func TestEvalExpression(t *testing.T) {
+	variables := map[string]float64{"x": 4, "y": 0.5}
+	for expression, expected := range map[string]float64{
+		"1":                 1,
+		"-x + +y":           -3.5,
+		"(x + y) * 2":       9,
+		"x / y / 2":         4,
+		"max(x, y, 10) - 1": 9,
+		"1.5e3":             1500,
+		"0 / (x - 4)":       0,
+	} {
+		expr, err := parser.ParseExpr(expression)
+		require.NoError(t, err)
+		value, err := evalExpression(expr, variables)
+		require.NoError(t, err, expression)
+		require.Equal(t, expected, value, expression)
+	}
+}
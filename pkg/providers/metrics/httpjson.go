@@ -0,0 +1,150 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/jsonpath"
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultHTTPJSONTimeout = 10 * time.Second
+)
+
+// Generic http JSON metrics provider adapter. Each metric is read by calling
+// an http endpoint and extracting numeric values from its JSON response using
+// JSONPath.
+// see: pkg/providers/metrics/proto/httpjson.proto
+type httpJSON struct {
+	client *http.Client
+}
+
+type httpJSONFactory struct{}
+
+func init() {
+	providers.RegisterMetricsClient(&proto.HTTPJSONConfig{}, &proto.HTTPJSONMetricConfig{}, &httpJSONFactory{})
+}
+
+func (f *httpJSONFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	httpJSONConfig := proto.HTTPJSONConfig{}
+	if err := anypb.UnmarshalTo(config, &httpJSONConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &httpJSON{
+		client: &http.Client{},
+	}, nil
+}
+
+// Metrics with {autoscalerName} or {namespace} placeholders are autoscaler
+// scoped.
+func (h *httpJSON) AutoscalerScoped(config *anypb.Any) bool {
+	metricConfig := proto.HTTPJSONMetricConfig{}
+	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+		return true
+	}
+	hasPlaceholders := func(s string) bool {
+		return strings.Contains(s, "{autoscalerName}") || strings.Contains(s, "{namespace}")
+	}
+	if hasPlaceholders(metricConfig.Url) || hasPlaceholders(metricConfig.Body) {
+		return true
+	}
+	for _, value := range metricConfig.Headers {
+		if hasPlaceholders(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *httpJSON) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	metricConfig := proto.HTTPJSONMetricConfig{}
+	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(metricConfig.Url) == 0 {
+		return nil, time.Time{}, fmt.Errorf("url is required")
+	}
+	if len(metricConfig.JsonPath) == 0 {
+		return nil, time.Time{}, fmt.Errorf("json path is required")
+	}
+	parser, err := jsonpath.Parse(metricName, metricConfig.JsonPath)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	timeout := defaultHTTPJSONTimeout
+	if metricConfig.Timeout != nil {
+		timeout = metricConfig.Timeout.AsDuration()
+	}
+
+	placeholders := strings.NewReplacer(
+		"{autoscalerName}", autoscalerName,
+		"{namespace}", namespace,
+		"{metricName}", metricName)
+	urlPlaceholders := strings.NewReplacer(
+		"{autoscalerName}", url.PathEscape(autoscalerName),
+		"{namespace}", url.PathEscape(namespace),
+		"{metricName}", url.PathEscape(metricName))
+
+	var body io.Reader
+	method := http.MethodGet
+	if metricConfig.Method == proto.HTTPJSONMetricConfig_POST {
+		method = http.MethodPost
+		body = bytes.NewBufferString(placeholders.Replace(metricConfig.Body))
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, urlPlaceholders.Replace(metricConfig.Url), body)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range metricConfig.Headers {
+		req.Header.Set(name, placeholders.Replace(value))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Time{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	timestamp := time.Now()
+
+	var data interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decode response: %v", err)
+	}
+	values, err := jsonpath.Values(parser, data)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	klog.V(4).InfoS("http json metrics", "metric", metricName, "autoscaler", autoscalerName, "namespace", namespace, "values", values)
+
+	return values, timestamp, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPJSONGetMetric(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token-testns" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			require.Equal(t, "/status/testns/testas", r.URL.Path)
+			w.Write([]byte(`{"load": 42.5, "queues": [{"name": "a", "depth": 3}, {"name": "b", "depth": "7"}], "name": "x"}`))
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"autoscaler": "testas", "metric": "testmetric"}`, string(body))
+			w.Write([]byte(`{"result": {"value": 9}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := (&httpJSONFactory{}).MetricsClient(mustAny(t, &proto.HTTPJSONConfig{}))
+	require.NoError(t, err)
+	getMetric := func(config *proto.HTTPJSONMetricConfig) ([]float64, error) {
+		if config.Headers == nil {
+			config.Headers = map[string]string{"Authorization": "token-{namespace}"}
+		}
+		values, _, err := client.GetMetric(context.Background(), "testmetric", "testas", "testns", mustAny(t, config))
+		return values, err
+	}
+	statusURL := server.URL + "/status/{namespace}/{autoscalerName}"
+
+	values, err := getMetric(&proto.HTTPJSONMetricConfig{Url: statusURL, JsonPath: "{.load}"})
+	require.NoError(t, err)
+	require.Equal(t, []float64{42.5}, values)
+
+	values, err = getMetric(&proto.HTTPJSONMetricConfig{Url: statusURL, JsonPath: ".queues[*].depth"})
+	require.NoError(t, err)
+	require.Equal(t, []float64{3, 7}, values)
+
+	values, err = getMetric(&proto.HTTPJSONMetricConfig{
+		Url:      server.URL,
+		Method:   proto.HTTPJSONMetricConfig_POST,
+		Body:     `{"autoscaler": "{autoscalerName}", "metric": "{metricName}"}`,
+		JsonPath: "$.result.value",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []float64{9}, values)
+
+	// errors
+	for _, config := range []*proto.HTTPJSONMetricConfig{
+		{Url: statusURL, JsonPath: "{.name}"},
+		{Url: statusURL, JsonPath: "{.missing}"},
+		{Url: statusURL, JsonPath: "{.queues[*]}"},
+		{Url: statusURL, JsonPath: "{.load"},
+		{Url: statusURL},
+		{JsonPath: "{.load}"},
+		{Url: statusURL, JsonPath: "{.load}", Headers: map[string]string{}},
+	} {
+		_, err = getMetric(config)
+		require.Error(t, err, config.JsonPath)
+	}
+}
+
+func TestHTTPJSONAutoscalerScoped(t *testing.T) {
+	h := &httpJSON{}
+	for config, expected := range map[*proto.HTTPJSONMetricConfig]bool{
+		{Url: "http://myservice/status"}:                                                                   false,
+		{Url: "http://myservice/{metricName}"}:                                                             false,
+		{Url: "http://myservice/status/{namespace}"}:                                                       true,
+		{Url: "http://myservice/status", Body: `{"as": "{autoscalerName}"}`}:                               true,
+		{Url: "http://myservice/status", Headers: map[string]string{"Authorization": "token-{namespace}"}}: true,
+	} {
+		require.Equal(t, expected, h.AutoscalerScoped(mustAny(t, config)), config.String())
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultPrometheusQueryTimeout = 10 * time.Second
+)
+
+// Prometheus metrics provider adapter. Each metric is an instant PromQL query
+// evaluated against the server defined in its config.
+// see: pkg/providers/metrics/proto/prometheus.proto
+type prometheus struct{}
+
+type prometheusFactory struct{}
+
+// An http round tripper that adds authentication and custom headers to
+// each request.
+type prometheusRoundTripper struct {
+	config *proto.PrometheusMetricConfig
+	next   http.RoundTripper
+}
+
+func init() {
+	providers.RegisterMetricsClient(&proto.PrometheusConfig{}, &proto.PrometheusMetricConfig{}, &prometheusFactory{})
+}
+
+func (f *prometheusFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	prometheusConfig := proto.PrometheusConfig{}
+	if err := anypb.UnmarshalTo(config, &prometheusConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &prometheus{}, nil
+}
+
+func (p *prometheus) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	metricConfig := proto.PrometheusMetricConfig{}
+	if err := anypb.UnmarshalTo(config, &metricConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(metricConfig.Url) == 0 {
+		return nil, time.Time{}, fmt.Errorf("url is required")
+	}
+	if len(metricConfig.Query) == 0 {
+		return nil, time.Time{}, fmt.Errorf("query is required")
+	}
+	timeout := defaultPrometheusQueryTimeout
+	if metricConfig.Timeout != nil {
+		timeout = metricConfig.Timeout.AsDuration()
+	}
+
+	client, err := promapi.NewClient(promapi.Config{
+		Address: metricConfig.Url,
+		RoundTripper: &prometheusRoundTripper{
+			config: &metricConfig,
+			next:   promapi.DefaultRoundTripper,
+		},
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to create prometheus client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	result, warnings, err := promv1.NewAPI(client).Query(ctx, metricConfig.Query, time.Now())
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to query prometheus: %v", err)
+	}
+	if len(warnings) > 0 {
+		klog.V(1).InfoS("prometheus query warnings", "metric", metricName, "autoscaler", autoscalerName, "namespace", namespace, "warnings", warnings)
+	}
+
+	values, timestamp, err := prometheusResultValues(result)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	klog.V(4).InfoS("prometheus metrics", "metric", metricName, "values", values, "timestamp", timestamp)
+
+	return values, timestamp, nil
+}
+
+// Converts a scalar or instant vector query result to metric values. Returned
+// timestamp is the latest sample timestamp.
+func prometheusResultValues(result model.Value) ([]float64, time.Time, error) {
+	var samples []*model.Sample
+	switch v := result.(type) {
+	case *model.Scalar:
+		samples = []*model.Sample{{Value: v.Value, Timestamp: v.Timestamp}}
+	case model.Vector:
+		samples = v
+	default:
+		return nil, time.Time{}, fmt.Errorf("unsupported query result type: %s", result.Type())
+	}
+	if len(samples) == 0 {
+		return nil, time.Time{}, fmt.Errorf("query returned no samples")
+	}
+
+	values := make([]float64, len(samples))
+	timestamp := time.Time{}
+	for i, sample := range samples {
+		value := float64(sample.Value)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, time.Time{}, fmt.Errorf("invalid sample value %v for %s", value, sample.Metric)
+		}
+		values[i] = value
+		if sampleTime := sample.Timestamp.Time(); sampleTime.After(timestamp) {
+			timestamp = sampleTime
+		}
+	}
+
+	return values, timestamp, nil
+}
+
+func (rt *prometheusRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range rt.config.Headers {
+		req.Header.Set(name, value)
+	}
+	if rt.config.BearerToken != nil {
+		req.Header.Set("Authorization", "Bearer "+*rt.config.BearerToken)
+	}
+	if rt.config.BasicAuth != nil {
+		req.SetBasicAuth(rt.config.BasicAuth.Username, rt.config.BasicAuth.Password)
+	}
+
+	return rt.next.RoundTrip(req)
+}
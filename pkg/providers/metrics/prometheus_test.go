@@ -0,0 +1,90 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"github.com/stretchr/testify/require"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestPrometheusGetMetric(t *testing.T) {
+	responses := map[string]string{
+		"queue_depth": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"queue":"a"},"value":[1700000000,"12.7"]},
+			{"metric":{"queue":"b"},"value":[1700000010,"30"]}]}}`,
+		"scalar(rate)": `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"5"]}}`,
+		"empty":        `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"nan":          `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"NaN"]}]}}`,
+		"matrix[1m]":   `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/query", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "testuser" || password != "testpassword" || r.Header.Get("X-Test") != "testvalue" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response, ok := responses[r.Form.Get("query")]
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client, err := (&prometheusFactory{}).MetricsClient(mustAny(t, &proto.PrometheusConfig{}))
+	require.NoError(t, err)
+	getMetric := func(query string, basicAuth *proto.PrometheusBasicAuth) ([]float64, time.Time, error) {
+		return client.GetMetric(
+			context.Background(),
+			"testmetric",
+			"testas",
+			"testns",
+			mustAny(t, &proto.PrometheusMetricConfig{
+				Url:       server.URL,
+				Query:     query,
+				BasicAuth: basicAuth,
+				Headers:   map[string]string{"X-Test": "testvalue"},
+			}))
+	}
+	auth := &proto.PrometheusBasicAuth{Username: "testuser", Password: "testpassword"}
+
+	values, timestamp, err := getMetric("queue_depth", auth)
+	require.NoError(t, err)
+	require.Equal(t, []float64{12.7, 30}, values)
+	require.Equal(t, time.Unix(1700000010, 0), timestamp)
+
+	values, _, err = getMetric("scalar(rate)", auth)
+	require.NoError(t, err)
+	require.Equal(t, []float64{5}, values)
+
+	for _, query := range []string{"empty", "nan", "matrix[1m]", "invalid query"} {
+		_, _, err = getMetric(query, auth)
+		require.Error(t, err, query)
+	}
+
+	_, _, err = getMetric("queue_depth", nil)
+	require.Error(t, err)
+	_, _, err = getMetric("", auth)
+	require.Error(t, err)
+}
+
+func mustAny(t *testing.T, message protob.Message) *anypb.Any {
+	a, err := anypb.New(message)
+	require.NoError(t, err)
+	return a
+}
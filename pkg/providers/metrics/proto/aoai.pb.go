@@ -23,14 +23,30 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Supported metrics. Metric name must be one of these.
 type AzureOAIMetricConfig_Metric int32
 
 const (
 	AzureOAIMetricConfig_None AzureOAIMetricConfig_Metric = 0
 	// Measures the percentage of rate of 429 request to total rate of
-	// requests.
+	// requests in the last 10 minutes. No requests is reported as 0%.
 	// Useful for Provisioned Managed offers.
 	AzureOAIMetricConfig_Percent429Rate AzureOAIMetricConfig_Metric = 1
+	// Percentage of provisioned managed capacity in use.
+	AzureOAIMetricConfig_ProvisionedManagedUtilization AzureOAIMetricConfig_Metric = 2
+	// Number of prompt tokens processed per minute.
+	AzureOAIMetricConfig_ProcessedPromptTokensPerMinute AzureOAIMetricConfig_Metric = 3
+	// Number of completion tokens generated per minute.
+	AzureOAIMetricConfig_GeneratedTokensPerMinute AzureOAIMetricConfig_Metric = 4
+	// Percentiles of per-minute mean time to first response in
+	// milliseconds in the last 10 minutes. Azure Monitor only provides
+	// per-minute means, so these are not percentiles of individual
+	// requests and understate tail latency.
+	AzureOAIMetricConfig_TimeToResponseMinuteMeanP50 AzureOAIMetricConfig_Metric = 5
+	AzureOAIMetricConfig_TimeToResponseMinuteMeanP90 AzureOAIMetricConfig_Metric = 6
+	AzureOAIMetricConfig_TimeToResponseMinuteMeanP99 AzureOAIMetricConfig_Metric = 7
+	// Number of active (non-cached) tokens processed per minute.
+	AzureOAIMetricConfig_ActiveTokensPerMinute AzureOAIMetricConfig_Metric = 8
 )
 
 // Enum value maps for AzureOAIMetricConfig_Metric.
@@ -38,10 +54,24 @@ var (
 	AzureOAIMetricConfig_Metric_name = map[int32]string{
 		0: "None",
 		1: "Percent429Rate",
+		2: "ProvisionedManagedUtilization",
+		3: "ProcessedPromptTokensPerMinute",
+		4: "GeneratedTokensPerMinute",
+		5: "TimeToResponseMinuteMeanP50",
+		6: "TimeToResponseMinuteMeanP90",
+		7: "TimeToResponseMinuteMeanP99",
+		8: "ActiveTokensPerMinute",
 	}
 	AzureOAIMetricConfig_Metric_value = map[string]int32{
-		"None":           0,
-		"Percent429Rate": 1,
+		"None":                           0,
+		"Percent429Rate":                 1,
+		"ProvisionedManagedUtilization":  2,
+		"ProcessedPromptTokensPerMinute": 3,
+		"GeneratedTokensPerMinute":       4,
+		"TimeToResponseMinuteMeanP50":    5,
+		"TimeToResponseMinuteMeanP90":    6,
+		"TimeToResponseMinuteMeanP99":    7,
+		"ActiveTokensPerMinute":          8,
 	}
 )
 
@@ -184,27 +214,42 @@ var file_aoai_proto_rawDesc = []byte{
 	0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x41, 0x7a, 0x75, 0x72,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x14, 0x41, 0x7a, 0x75, 0x72,
 	0x65, 0x4f, 0x41, 0x49, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x52, 0x49, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
 	0x52, 0x49, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
 	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x06, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x34, 0x32, 0x39, 0x52, 0x61, 0x74, 0x65,
-	0x10, 0x01, 0x22, 0x7d, 0x0a, 0x0e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4f, 0x41, 0x49, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x6b, 0x0a, 0x14, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x12, 0x61,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x89, 0x02, 0x0a, 0x06, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x34, 0x32, 0x39, 0x52, 0x61, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x65, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50, 0x65, 0x72,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x4d, 0x65, 0x61, 0x6e, 0x50, 0x35, 0x30, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x69, 0x6d,
+	0x65, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6e, 0x50, 0x39, 0x30, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6e, 0x50, 0x39, 0x39, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x10, 0x08, 0x22, 0x7d, 0x0a, 0x0e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4f,
+	0x41, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x6b, 0x0a, 0x14, 0x61, 0x7a, 0x75, 0x72,
+	0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
 	0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x32, 0x5a, 0x30, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x52, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x5a, 0x30, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74,
+	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -12,6 +12,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +24,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Combination of values of multiple time series, for example when filter
+// splits by a dimension.
+type AzureMonitorCombine int32
+
+const (
+	// Return one value per time series. Autoscaler uses their sum.
+	AzureMonitorCombine_PerTimeSeries AzureMonitorCombine = 0
+	// Sum of all time series values.
+	AzureMonitorCombine_Sum AzureMonitorCombine = 1
+	// Average of all time series values.
+	AzureMonitorCombine_Average AzureMonitorCombine = 2
+	// Maximum of all time series values.
+	AzureMonitorCombine_Maximum AzureMonitorCombine = 3
+)
+
+// Enum value maps for AzureMonitorCombine.
+var (
+	AzureMonitorCombine_name = map[int32]string{
+		0: "PerTimeSeries",
+		1: "Sum",
+		2: "Average",
+		3: "Maximum",
+	}
+	AzureMonitorCombine_value = map[string]int32{
+		"PerTimeSeries": 0,
+		"Sum":           1,
+		"Average":       2,
+		"Maximum":       3,
+	}
+)
+
+func (x AzureMonitorCombine) Enum() *AzureMonitorCombine {
+	p := new(AzureMonitorCombine)
+	*p = x
+	return p
+}
+
+func (x AzureMonitorCombine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AzureMonitorCombine) Descriptor() protoreflect.EnumDescriptor {
+	return file_azuremonitor_proto_enumTypes[0].Descriptor()
+}
+
+func (AzureMonitorCombine) Type() protoreflect.EnumType {
+	return &file_azuremonitor_proto_enumTypes[0]
+}
+
+func (x AzureMonitorCombine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AzureMonitorCombine.Descriptor instead.
+func (AzureMonitorCombine) EnumDescriptor() ([]byte, []int) {
+	return file_azuremonitor_proto_rawDescGZIP(), []int{0}
+}
+
 // Metric aggregation type as supported by the metric.
 type AzureMonitorMetricConfig_Aggregation int32
 
@@ -69,11 +128,11 @@ func (x AzureMonitorMetricConfig_Aggregation) String() string {
 }
 
 func (AzureMonitorMetricConfig_Aggregation) Descriptor() protoreflect.EnumDescriptor {
-	return file_azuremonitor_proto_enumTypes[0].Descriptor()
+	return file_azuremonitor_proto_enumTypes[1].Descriptor()
 }
 
 func (AzureMonitorMetricConfig_Aggregation) Type() protoreflect.EnumType {
-	return &file_azuremonitor_proto_enumTypes[0]
+	return &file_azuremonitor_proto_enumTypes[1]
 }
 
 func (x AzureMonitorMetricConfig_Aggregation) Number() protoreflect.EnumNumber {
@@ -85,6 +144,68 @@ func (AzureMonitorMetricConfig_Aggregation) EnumDescriptor() ([]byte, []int) {
 	return file_azuremonitor_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Reduction of the data points returned within timespan into a single
+// value. Data points without a value for the aggregation are ignored.
+type AzureMonitorMetricConfig_Reducer int32
+
+const (
+	// Value of the latest data point.
+	AzureMonitorMetricConfig_Latest AzureMonitorMetricConfig_Reducer = 0
+	// Average of all data points.
+	AzureMonitorMetricConfig_Mean AzureMonitorMetricConfig_Reducer = 1
+	// Maximum of all data points.
+	AzureMonitorMetricConfig_Max AzureMonitorMetricConfig_Reducer = 2
+	// Nearest-rank percentile of all data points. See percentile.
+	AzureMonitorMetricConfig_Percentile AzureMonitorMetricConfig_Reducer = 3
+	// Sum of all data points.
+	AzureMonitorMetricConfig_Sum AzureMonitorMetricConfig_Reducer = 4
+)
+
+// Enum value maps for AzureMonitorMetricConfig_Reducer.
+var (
+	AzureMonitorMetricConfig_Reducer_name = map[int32]string{
+		0: "Latest",
+		1: "Mean",
+		2: "Max",
+		3: "Percentile",
+		4: "Sum",
+	}
+	AzureMonitorMetricConfig_Reducer_value = map[string]int32{
+		"Latest":     0,
+		"Mean":       1,
+		"Max":        2,
+		"Percentile": 3,
+		"Sum":        4,
+	}
+)
+
+func (x AzureMonitorMetricConfig_Reducer) Enum() *AzureMonitorMetricConfig_Reducer {
+	p := new(AzureMonitorMetricConfig_Reducer)
+	*p = x
+	return p
+}
+
+func (x AzureMonitorMetricConfig_Reducer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AzureMonitorMetricConfig_Reducer) Descriptor() protoreflect.EnumDescriptor {
+	return file_azuremonitor_proto_enumTypes[2].Descriptor()
+}
+
+func (AzureMonitorMetricConfig_Reducer) Type() protoreflect.EnumType {
+	return &file_azuremonitor_proto_enumTypes[2]
+}
+
+func (x AzureMonitorMetricConfig_Reducer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AzureMonitorMetricConfig_Reducer.Descriptor instead.
+func (AzureMonitorMetricConfig_Reducer) EnumDescriptor() ([]byte, []int) {
+	return file_azuremonitor_proto_rawDescGZIP(), []int{0, 1}
+}
+
 type AzureMonitorMetricConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -98,6 +219,17 @@ type AzureMonitorMetricConfig struct {
 	Aggregation AzureMonitorMetricConfig_Aggregation `protobuf:"varint,3,opt,name=aggregation,proto3,enum=k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig_Aggregation" json:"aggregation,omitempty"`
 	// Filter values using expressions.
 	Filter *string `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Lookback window of the query. Defaults to 10m.
+	Timespan *durationpb.Duration `protobuf:"bytes,5,opt,name=timespan,proto3,oneof" json:"timespan,omitempty"`
+	// Granularity of returned data points. Must be supported by the metric,
+	// for example 1m, 5m or 1h. Defaults to 1m.
+	Interval *durationpb.Duration `protobuf:"bytes,6,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	// Reduction of data points into a single value. Defaults to Latest.
+	Reducer AzureMonitorMetricConfig_Reducer `protobuf:"varint,7,opt,name=reducer,proto3,enum=k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig_Reducer" json:"reducer,omitempty"`
+	// Percentile in (0, 100] when reducer is Percentile.
+	Percentile float64 `protobuf:"fixed64,8,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// Combination of multiple time series values. Defaults to PerTimeSeries.
+	Combine AzureMonitorCombine `protobuf:"varint,9,opt,name=combine,proto3,enum=k9sautoscaler.providers.metrics.proto.AzureMonitorCombine" json:"combine,omitempty"`
 }
 
 func (x *AzureMonitorMetricConfig) Reset() {
@@ -160,11 +292,46 @@ func (x *AzureMonitorMetricConfig) GetFilter() string {
 	return ""
 }
 
+func (x *AzureMonitorMetricConfig) GetTimespan() *durationpb.Duration {
+	if x != nil {
+		return x.Timespan
+	}
+	return nil
+}
+
+func (x *AzureMonitorMetricConfig) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *AzureMonitorMetricConfig) GetReducer() AzureMonitorMetricConfig_Reducer {
+	if x != nil {
+		return x.Reducer
+	}
+	return AzureMonitorMetricConfig_Latest
+}
+
+func (x *AzureMonitorMetricConfig) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *AzureMonitorMetricConfig) GetCombine() AzureMonitorCombine {
+	if x != nil {
+		return x.Combine
+	}
+	return AzureMonitorCombine_PerTimeSeries
+}
+
 // Configuration for Azure Monitor based metrics provider.
 // Authentication is handled using default Azure credential mechanism.
 // See: https://learn.microsoft.com/en-us/azure/developer/go/azure-sdk-authentication
-// It is important that the metrics query returns exactly 1 time series to be usable
-// in autoscaling.
+// Metrics queries may return multiple time series, see
+// AzureMonitorMetricConfig.combine.
 type AzureMonitorConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,7 +376,9 @@ var file_azuremonitor_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
 	0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x02, 0x0a, 0x18,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x06, 0x0a, 0x18,
 	0x41, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72,
 	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x55, 0x52, 0x49, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
@@ -225,19 +394,51 @@ var file_azuremonitor_proto_rawDesc = []byte{
 	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01,
-	0x22, 0x67, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75,
-	0x6d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x10, 0x03,
-	0x12, 0x09, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x10, 0x06, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x5a, 0x30, 0x6b, 0x39,
-	0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x3a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x07, 0x72, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x47, 0x2e, 0x6b, 0x39, 0x73, 0x61,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6b,
+	0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x65, 0x22, 0x67, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x10, 0x06, 0x22, 0x41, 0x0a, 0x07, 0x52, 0x65,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x65, 0x61, 0x6e, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d,
+	0x61, 0x78, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x75, 0x6d, 0x10, 0x04, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0x4b, 0x0a, 0x13, 0x41, 0x7a, 0x75, 0x72,
+	0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x75, 0x6d, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x10, 0x03, 0x42, 0x32, 0x5a, 0x30, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74,
+	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -252,20 +453,27 @@ func file_azuremonitor_proto_rawDescGZIP() []byte {
 	return file_azuremonitor_proto_rawDescData
 }
 
-var file_azuremonitor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_azuremonitor_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_azuremonitor_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_azuremonitor_proto_goTypes = []interface{}{
-	(AzureMonitorMetricConfig_Aggregation)(0), // 0: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Aggregation
-	(*AzureMonitorMetricConfig)(nil),          // 1: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig
-	(*AzureMonitorConfig)(nil),                // 2: k9sautoscaler.providers.metrics.proto.AzureMonitorConfig
+	(AzureMonitorCombine)(0),                  // 0: k9sautoscaler.providers.metrics.proto.AzureMonitorCombine
+	(AzureMonitorMetricConfig_Aggregation)(0), // 1: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Aggregation
+	(AzureMonitorMetricConfig_Reducer)(0),     // 2: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Reducer
+	(*AzureMonitorMetricConfig)(nil),          // 3: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig
+	(*AzureMonitorConfig)(nil),                // 4: k9sautoscaler.providers.metrics.proto.AzureMonitorConfig
+	(*durationpb.Duration)(nil),               // 5: google.protobuf.Duration
 }
 var file_azuremonitor_proto_depIdxs = []int32{
-	0, // 0: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.aggregation:type_name -> k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Aggregation
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.aggregation:type_name -> k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Aggregation
+	5, // 1: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.timespan:type_name -> google.protobuf.Duration
+	5, // 2: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.interval:type_name -> google.protobuf.Duration
+	2, // 3: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.reducer:type_name -> k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.Reducer
+	0, // 4: k9sautoscaler.providers.metrics.proto.AzureMonitorMetricConfig.combine:type_name -> k9sautoscaler.providers.metrics.proto.AzureMonitorCombine
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_azuremonitor_proto_init() }
@@ -305,7 +513,7 @@ func file_azuremonitor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_azuremonitor_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      3,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,338 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.0--rc2
+// source: httpjson.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HTTPJSONMetricConfig_Method int32
+
+const (
+	HTTPJSONMetricConfig_GET  HTTPJSONMetricConfig_Method = 0
+	HTTPJSONMetricConfig_POST HTTPJSONMetricConfig_Method = 1
+)
+
+// Enum value maps for HTTPJSONMetricConfig_Method.
+var (
+	HTTPJSONMetricConfig_Method_name = map[int32]string{
+		0: "GET",
+		1: "POST",
+	}
+	HTTPJSONMetricConfig_Method_value = map[string]int32{
+		"GET":  0,
+		"POST": 1,
+	}
+)
+
+func (x HTTPJSONMetricConfig_Method) Enum() *HTTPJSONMetricConfig_Method {
+	p := new(HTTPJSONMetricConfig_Method)
+	*p = x
+	return p
+}
+
+func (x HTTPJSONMetricConfig_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HTTPJSONMetricConfig_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_httpjson_proto_enumTypes[0].Descriptor()
+}
+
+func (HTTPJSONMetricConfig_Method) Type() protoreflect.EnumType {
+	return &file_httpjson_proto_enumTypes[0]
+}
+
+func (x HTTPJSONMetricConfig_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HTTPJSONMetricConfig_Method.Descriptor instead.
+func (HTTPJSONMetricConfig_Method) EnumDescriptor() ([]byte, []int) {
+	return file_httpjson_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Metric read from a JSON http endpoint.
+// url, header values and body may contain {autoscalerName}, {namespace} and
+// {metricName} placeholders that are replaced for each request.
+type HTTPJSONMetricConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Endpoint URL. For example: http://myservice/status/{namespace}/{autoscalerName}
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Request method. Defaults to GET.
+	Method HTTPJSONMetricConfig_Method `protobuf:"varint,2,opt,name=method,proto3,enum=k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig_Method" json:"method,omitempty"`
+	// Optional request headers.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional request body for POST requests.
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// JSONPath expression used to extract one or more numeric values from the
+	// response. Both quoted numbers and numbers are accepted.
+	// For example: {.queues[*].depth}
+	// See: https://kubernetes.io/docs/reference/kubectl/jsonpath/
+	JsonPath string `protobuf:"bytes,5,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	// Optional request timeout. Defaults to 10s.
+	Timeout *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+}
+
+func (x *HTTPJSONMetricConfig) Reset() {
+	*x = HTTPJSONMetricConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_httpjson_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPJSONMetricConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPJSONMetricConfig) ProtoMessage() {}
+
+func (x *HTTPJSONMetricConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_httpjson_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPJSONMetricConfig.ProtoReflect.Descriptor instead.
+func (*HTTPJSONMetricConfig) Descriptor() ([]byte, []int) {
+	return file_httpjson_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HTTPJSONMetricConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HTTPJSONMetricConfig) GetMethod() HTTPJSONMetricConfig_Method {
+	if x != nil {
+		return x.Method
+	}
+	return HTTPJSONMetricConfig_GET
+}
+
+func (x *HTTPJSONMetricConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPJSONMetricConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *HTTPJSONMetricConfig) GetJsonPath() string {
+	if x != nil {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (x *HTTPJSONMetricConfig) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Configuration for generic http JSON metrics provider. Endpoints are defined
+// per metric in HTTPJSONMetricConfig.
+type HTTPJSONConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HTTPJSONConfig) Reset() {
+	*x = HTTPJSONConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_httpjson_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPJSONConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPJSONConfig) ProtoMessage() {}
+
+func (x *HTTPJSONConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_httpjson_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPJSONConfig.ProtoReflect.Descriptor instead.
+func (*HTTPJSONConfig) Descriptor() ([]byte, []int) {
+	return file_httpjson_proto_rawDescGZIP(), []int{1}
+}
+
+var File_httpjson_proto protoreflect.FileDescriptor
+
+var file_httpjson_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x25, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x03, 0x0a, 0x14, 0x48, 0x54, 0x54, 0x50,
+	0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x5a, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x42, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4a,
+	0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x62,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x48, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4a, 0x53, 0x4f, 0x4e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1b, 0x0a, 0x06, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x54, 0x54, 0x50, 0x4a, 0x53, 0x4f, 0x4e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x5a, 0x30, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_httpjson_proto_rawDescOnce sync.Once
+	file_httpjson_proto_rawDescData = file_httpjson_proto_rawDesc
+)
+
+func file_httpjson_proto_rawDescGZIP() []byte {
+	file_httpjson_proto_rawDescOnce.Do(func() {
+		file_httpjson_proto_rawDescData = protoimpl.X.CompressGZIP(file_httpjson_proto_rawDescData)
+	})
+	return file_httpjson_proto_rawDescData
+}
+
+var file_httpjson_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_httpjson_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_httpjson_proto_goTypes = []interface{}{
+	(HTTPJSONMetricConfig_Method)(0), // 0: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.Method
+	(*HTTPJSONMetricConfig)(nil),     // 1: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig
+	(*HTTPJSONConfig)(nil),           // 2: k9sautoscaler.providers.metrics.proto.HTTPJSONConfig
+	nil,                              // 3: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.HeadersEntry
+	(*durationpb.Duration)(nil),      // 4: google.protobuf.Duration
+}
+var file_httpjson_proto_depIdxs = []int32{
+	0, // 0: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.method:type_name -> k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.Method
+	3, // 1: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.headers:type_name -> k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.HeadersEntry
+	4, // 2: k9sautoscaler.providers.metrics.proto.HTTPJSONMetricConfig.timeout:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_httpjson_proto_init() }
+func file_httpjson_proto_init() {
+	if File_httpjson_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_httpjson_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPJSONMetricConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_httpjson_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPJSONConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_httpjson_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_httpjson_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_httpjson_proto_goTypes,
+		DependencyIndexes: file_httpjson_proto_depIdxs,
+		EnumInfos:         file_httpjson_proto_enumTypes,
+		MessageInfos:      file_httpjson_proto_msgTypes,
+	}.Build()
+	File_httpjson_proto = out.File
+	file_httpjson_proto_rawDesc = nil
+	file_httpjson_proto_goTypes = nil
+	file_httpjson_proto_depIdxs = nil
+}
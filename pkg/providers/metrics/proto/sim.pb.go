@@ -24,16 +24,90 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How load changes over the timespan of an entry.
+type MetricLoad_Interpolation int32
+
+const (
+	// Constant load.
+	MetricLoad_Step MetricLoad_Interpolation = 0
+	// Linear ramp from load to end_load.
+	MetricLoad_Linear MetricLoad_Interpolation = 1
+	// Sine cycle of amplitude around load. A period of 24h simulates
+	// diurnal traffic.
+	MetricLoad_Sine MetricLoad_Interpolation = 2
+	// Replay of a recorded time series.
+	MetricLoad_Trace MetricLoad_Interpolation = 3
+)
+
+// Enum value maps for MetricLoad_Interpolation.
+var (
+	MetricLoad_Interpolation_name = map[int32]string{
+		0: "Step",
+		1: "Linear",
+		2: "Sine",
+		3: "Trace",
+	}
+	MetricLoad_Interpolation_value = map[string]int32{
+		"Step":   0,
+		"Linear": 1,
+		"Sine":   2,
+		"Trace":  3,
+	}
+)
+
+func (x MetricLoad_Interpolation) Enum() *MetricLoad_Interpolation {
+	p := new(MetricLoad_Interpolation)
+	*p = x
+	return p
+}
+
+func (x MetricLoad_Interpolation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricLoad_Interpolation) Descriptor() protoreflect.EnumDescriptor {
+	return file_sim_proto_enumTypes[0].Descriptor()
+}
+
+func (MetricLoad_Interpolation) Type() protoreflect.EnumType {
+	return &file_sim_proto_enumTypes[0]
+}
+
+func (x MetricLoad_Interpolation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricLoad_Interpolation.Descriptor instead.
+func (MetricLoad_Interpolation) EnumDescriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Define a load entry for a given duration.
 type MetricLoad struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Duration to maintain load.
+	// Duration to maintain load. Optional for Trace, where it defaults to
+	// the duration of the trace.
 	Timespan *durationpb.Duration `protobuf:"bytes,1,opt,name=timespan,proto3" json:"timespan,omitempty"`
-	// Simulated total load.
-	Load float64 `protobuf:"fixed64,2,opt,name=load,proto3" json:"load,omitempty"`
+	// Simulated total load. Start load for Linear and mean load for Sine.
+	Load          float64                  `protobuf:"fixed64,2,opt,name=load,proto3" json:"load,omitempty"`
+	Interpolation MetricLoad_Interpolation `protobuf:"varint,3,opt,name=interpolation,proto3,enum=k9sautoscaler.providers.metrics.proto.MetricLoad_Interpolation" json:"interpolation,omitempty"`
+	// Load at the end of timespan for Linear.
+	EndLoad float64 `protobuf:"fixed64,4,opt,name=end_load,json=endLoad,proto3" json:"end_load,omitempty"`
+	// Sine amplitude.
+	Amplitude float64 `protobuf:"fixed64,5,opt,name=amplitude,proto3" json:"amplitude,omitempty"`
+	// Sine period. Defaults to timespan.
+	Period *durationpb.Duration `protobuf:"bytes,6,opt,name=period,proto3,oneof" json:"period,omitempty"`
+	// Sine phase offset, e.g. 6h to peak at noon with a 24h period.
+	Phase *durationpb.Duration `protobuf:"bytes,7,opt,name=phase,proto3,oneof" json:"phase,omitempty"`
+	// Recorded time series for Trace.
+	Trace *LoadTrace `protobuf:"bytes,8,opt,name=trace,proto3" json:"trace,omitempty"`
+	// Optional periodic bursts on top of load.
+	Burst *LoadBurst `protobuf:"bytes,9,opt,name=burst,proto3,oneof" json:"burst,omitempty"`
+	// Optional random noise on top of load.
+	Noise *LoadNoise `protobuf:"bytes,10,opt,name=noise,proto3,oneof" json:"noise,omitempty"`
 }
 
 func (x *MetricLoad) Reset() {
@@ -82,6 +156,325 @@ func (x *MetricLoad) GetLoad() float64 {
 	return 0
 }
 
+func (x *MetricLoad) GetInterpolation() MetricLoad_Interpolation {
+	if x != nil {
+		return x.Interpolation
+	}
+	return MetricLoad_Step
+}
+
+func (x *MetricLoad) GetEndLoad() float64 {
+	if x != nil {
+		return x.EndLoad
+	}
+	return 0
+}
+
+func (x *MetricLoad) GetAmplitude() float64 {
+	if x != nil {
+		return x.Amplitude
+	}
+	return 0
+}
+
+func (x *MetricLoad) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *MetricLoad) GetPhase() *durationpb.Duration {
+	if x != nil {
+		return x.Phase
+	}
+	return nil
+}
+
+func (x *MetricLoad) GetTrace() *LoadTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *MetricLoad) GetBurst() *LoadBurst {
+	if x != nil {
+		return x.Burst
+	}
+	return nil
+}
+
+func (x *MetricLoad) GetNoise() *LoadNoise {
+	if x != nil {
+		return x.Noise
+	}
+	return nil
+}
+
+// Recorded load time series. Loads between points are interpolated.
+type LoadTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of a CSV or JSON file, by extension. CSV rows are "time,load"
+	// with an optional header row. JSON is an array of {"time", "load"}
+	// objects. Time is either seconds since start of trace or an RFC3339
+	// timestamp, in which case it is relative to the first point.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Step (default) holds each point until the next, Linear interpolates
+	// between points.
+	Interpolation MetricLoad_Interpolation `protobuf:"varint,2,opt,name=interpolation,proto3,enum=k9sautoscaler.providers.metrics.proto.MetricLoad_Interpolation" json:"interpolation,omitempty"`
+}
+
+func (x *LoadTrace) Reset() {
+	*x = LoadTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadTrace) ProtoMessage() {}
+
+func (x *LoadTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadTrace.ProtoReflect.Descriptor instead.
+func (*LoadTrace) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoadTrace) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LoadTrace) GetInterpolation() MetricLoad_Interpolation {
+	if x != nil {
+		return x.Interpolation
+	}
+	return MetricLoad_Step
+}
+
+// Periodic load bursts.
+type LoadBurst struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Extra load during a burst.
+	Load float64 `protobuf:"fixed64,1,opt,name=load,proto3" json:"load,omitempty"`
+	// Duration of each burst.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Time between start of bursts.
+	Every *durationpb.Duration `protobuf:"bytes,3,opt,name=every,proto3" json:"every,omitempty"`
+}
+
+func (x *LoadBurst) Reset() {
+	*x = LoadBurst{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadBurst) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBurst) ProtoMessage() {}
+
+func (x *LoadBurst) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBurst.ProtoReflect.Descriptor instead.
+func (*LoadBurst) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LoadBurst) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *LoadBurst) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *LoadBurst) GetEvery() *durationpb.Duration {
+	if x != nil {
+		return x.Every
+	}
+	return nil
+}
+
+// Random load noise. Noise is a function of seed and time, such that runs
+// with the same seed see the same load.
+type LoadNoise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Standard deviation of normally distributed noise.
+	Stddev float64 `protobuf:"fixed64,1,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	Seed   int64   `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Time for which noise is held. Defaults to 1s.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+}
+
+func (x *LoadNoise) Reset() {
+	*x = LoadNoise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadNoise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadNoise) ProtoMessage() {}
+
+func (x *LoadNoise) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadNoise.ProtoReflect.Descriptor instead.
+func (*LoadNoise) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoadNoise) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+func (x *LoadNoise) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *LoadNoise) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// A named simulated metric of an autoscaler.
+type SimMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Assumed max load per instance. Defaults to autoscaler
+	// max_load_per_instance.
+	MaxLoadPerInstance float64 `protobuf:"fixed64,2,opt,name=max_load_per_instance,json=maxLoadPerInstance,proto3" json:"max_load_per_instance,omitempty"`
+	// One or more load schedule entries.
+	Load []*MetricLoad `protobuf:"bytes,3,rep,name=load,proto3" json:"load,omitempty"`
+}
+
+func (x *SimMetric) Reset() {
+	*x = SimMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimMetric) ProtoMessage() {}
+
+func (x *SimMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimMetric.ProtoReflect.Descriptor instead.
+func (*SimMetric) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SimMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimMetric) GetMaxLoadPerInstance() float64 {
+	if x != nil {
+		return x.MaxLoadPerInstance
+	}
+	return 0
+}
+
+func (x *SimMetric) GetLoad() []*MetricLoad {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
 // Define a metrics configuration for an autoscaler.
 type AutoscalerConfig struct {
 	state         protoimpl.MessageState
@@ -92,14 +485,16 @@ type AutoscalerConfig struct {
 	AutoscalerNamespace string `protobuf:"bytes,2,opt,name=autoscaler_namespace,json=autoscalerNamespace,proto3" json:"autoscaler_namespace,omitempty"`
 	// Assumed max load per instance to be able to calculate load percentage.
 	MaxLoadPerInstance float64 `protobuf:"fixed64,3,opt,name=max_load_per_instance,json=maxLoadPerInstance,proto3" json:"max_load_per_instance,omitempty"`
-	// One or more load schedule entries.
+	// One or more load schedule entries of metric_name.
 	Load []*MetricLoad `protobuf:"bytes,4,rep,name=load,proto3" json:"load,omitempty"`
+	// Additional named metrics.
+	Metrics []*SimMetric `protobuf:"bytes,5,rep,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
 func (x *AutoscalerConfig) Reset() {
 	*x = AutoscalerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[1]
+		mi := &file_sim_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -112,7 +507,7 @@ func (x *AutoscalerConfig) String() string {
 func (*AutoscalerConfig) ProtoMessage() {}
 
 func (x *AutoscalerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[1]
+	mi := &file_sim_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,7 +520,7 @@ func (x *AutoscalerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoscalerConfig.ProtoReflect.Descriptor instead.
 func (*AutoscalerConfig) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{1}
+	return file_sim_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AutoscalerConfig) GetAutoscalerName() string {
@@ -156,21 +551,113 @@ func (x *AutoscalerConfig) GetLoad() []*MetricLoad {
 	return nil
 }
 
+func (x *AutoscalerConfig) GetMetrics() []*SimMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// Scaling simulation options.
+type SimScalingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time for new instances to be provisioned after scaling up. Until then,
+	// they are not part of current scale nor share load. Scaling down is
+	// immediate.
+	ProvisioningDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=provisioning_delay,json=provisioningDelay,proto3" json:"provisioning_delay,omitempty"`
+	// Probability in [0, 1] that a get or set scale call fails.
+	FailureRate float64 `protobuf:"fixed64,2,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	// Seed of failure injection.
+	Seed int64 `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Scale of autoscalers that have not been scaled yet. Defaults to 1.
+	InitialScale *int32 `protobuf:"varint,4,opt,name=initial_scale,json=initialScale,proto3,oneof" json:"initial_scale,omitempty"`
+}
+
+func (x *SimScalingConfig) Reset() {
+	*x = SimScalingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sim_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimScalingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimScalingConfig) ProtoMessage() {}
+
+func (x *SimScalingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_sim_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimScalingConfig.ProtoReflect.Descriptor instead.
+func (*SimScalingConfig) Descriptor() ([]byte, []int) {
+	return file_sim_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SimScalingConfig) GetProvisioningDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ProvisioningDelay
+	}
+	return nil
+}
+
+func (x *SimScalingConfig) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *SimScalingConfig) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SimScalingConfig) GetInitialScale() int32 {
+	if x != nil && x.InitialScale != nil {
+		return *x.InitialScale
+	}
+	return 0
+}
+
 // Simulated metrics config.
 // see: examples/intree/sim.yaml for an example.
+// As a scaling client config, an empty SimConfig uses the sim metrics client
+// for scaling. A SimConfig with scaling set scales autoscalers of the sim
+// metrics client with its own scaling options, or runs a standalone scaling
+// simulation of any autoscaler if there is no sim metrics client.
 type SimConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Name of the metric of autoscalers load. Optional if autoscalers only
+	// define metrics.
 	MetricName        string              `protobuf:"bytes,1,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"`
 	AutoscalersConfig []*AutoscalerConfig `protobuf:"bytes,2,rep,name=autoscalers_config,json=autoscalersConfig,proto3" json:"autoscalers_config,omitempty"`
+	Scaling           *SimScalingConfig   `protobuf:"bytes,3,opt,name=scaling,proto3,oneof" json:"scaling,omitempty"`
 }
 
 func (x *SimConfig) Reset() {
 	*x = SimConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[2]
+		mi := &file_sim_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -183,7 +670,7 @@ func (x *SimConfig) String() string {
 func (*SimConfig) ProtoMessage() {}
 
 func (x *SimConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[2]
+	mi := &file_sim_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +683,7 @@ func (x *SimConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimConfig.ProtoReflect.Descriptor instead.
 func (*SimConfig) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{2}
+	return file_sim_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SimConfig) GetMetricName() string {
@@ -213,6 +700,13 @@ func (x *SimConfig) GetAutoscalersConfig() []*AutoscalerConfig {
 	return nil
 }
 
+func (x *SimConfig) GetScaling() *SimScalingConfig {
+	if x != nil {
+		return x.Scaling
+	}
+	return nil
+}
+
 // Autoscaler metric config. Not used.
 type SimMetricConfig struct {
 	state         protoimpl.MessageState
@@ -223,7 +717,7 @@ type SimMetricConfig struct {
 func (x *SimMetricConfig) Reset() {
 	*x = SimMetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[3]
+		mi := &file_sim_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +730,7 @@ func (x *SimMetricConfig) String() string {
 func (*SimMetricConfig) ProtoMessage() {}
 
 func (x *SimMetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[3]
+	mi := &file_sim_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +743,7 @@ func (x *SimMetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimMetricConfig.ProtoReflect.Descriptor instead.
 func (*SimMetricConfig) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{3}
+	return file_sim_proto_rawDescGZIP(), []int{8}
 }
 
 // Dummy scaling target config. Not used for this provider.
@@ -262,7 +756,7 @@ type SimScalingTargetConfig struct {
 func (x *SimScalingTargetConfig) Reset() {
 	*x = SimScalingTargetConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sim_proto_msgTypes[4]
+		mi := &file_sim_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -275,7 +769,7 @@ func (x *SimScalingTargetConfig) String() string {
 func (*SimScalingTargetConfig) ProtoMessage() {}
 
 func (x *SimScalingTargetConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sim_proto_msgTypes[4]
+	mi := &file_sim_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +782,7 @@ func (x *SimScalingTargetConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimScalingTargetConfig.ProtoReflect.Descriptor instead.
 func (*SimScalingTargetConfig) Descriptor() ([]byte, []int) {
-	return file_sim_proto_rawDescGZIP(), []int{4}
+	return file_sim_proto_rawDescGZIP(), []int{9}
 }
 
 var File_sim_proto protoreflect.FileDescriptor
@@ -299,43 +793,139 @@ var file_sim_proto_rawDesc = []byte{
 	0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x57, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64,
-	0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x74, 0x6f, 0x22, 0xac, 0x05, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61,
+	0x64, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x65, 0x0a, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x39, 0x73, 0x61,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x75,
+	0x72, 0x73, 0x74, 0x48, 0x02, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x4b, 0x0a, 0x05, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x69, 0x73, 0x65,
+	0x48, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x88, 0x01, 0x01, 0x22, 0x3a, 0x0a, 0x0d,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x74, 0x65, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x72, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x69, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x10, 0x03, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x69, 0x73,
+	0x65, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x6b, 0x39, 0x73,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x4c,
+	0x6f, 0x61, 0x64, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x10,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x69,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x6d, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x61,
+	0x64, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x39, 0x73,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x04, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0xb4, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4a,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x10, 0x53,
+	0x69, 0x6d, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x12, 0x28, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xf8, 0x01, 0x0a,
+	0x09, 0x53, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x61,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74,
+	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x75, 0x74,
-	0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x15,
-	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78,
-	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x45, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
-	0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64,
-	0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x6d, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f,
-	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x11, 0x0a,
-	0x0f, 0x53, 0x69, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x22, 0x18, 0x0a, 0x16, 0x53, 0x69, 0x6d, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x31, 0x5a, 0x2f, 0x6b, 0x39,
-	0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x72, 0x69, 0x63,
-	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x56, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d,
+	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x07, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x69,
+	0x6d, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x31, 0x5a, 0x2f, 0x6b, 0x39, 0x73, 0x2d, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -350,24 +940,45 @@ func file_sim_proto_rawDescGZIP() []byte {
 	return file_sim_proto_rawDescData
 }
 
-var file_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sim_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_sim_proto_goTypes = []interface{}{
-	(*MetricLoad)(nil),             // 0: k9sautoscaler.providers.metrics.proto.MetricLoad
-	(*AutoscalerConfig)(nil),       // 1: k9sautoscaler.providers.metrics.proto.AutoscalerConfig
-	(*SimConfig)(nil),              // 2: k9sautoscaler.providers.metrics.proto.SimConfig
-	(*SimMetricConfig)(nil),        // 3: k9sautoscaler.providers.metrics.proto.SimMetricConfig
-	(*SimScalingTargetConfig)(nil), // 4: k9sautoscaler.providers.metrics.proto.SimScalingTargetConfig
-	(*durationpb.Duration)(nil),    // 5: google.protobuf.Duration
+	(MetricLoad_Interpolation)(0),  // 0: k9sautoscaler.providers.metrics.proto.MetricLoad.Interpolation
+	(*MetricLoad)(nil),             // 1: k9sautoscaler.providers.metrics.proto.MetricLoad
+	(*LoadTrace)(nil),              // 2: k9sautoscaler.providers.metrics.proto.LoadTrace
+	(*LoadBurst)(nil),              // 3: k9sautoscaler.providers.metrics.proto.LoadBurst
+	(*LoadNoise)(nil),              // 4: k9sautoscaler.providers.metrics.proto.LoadNoise
+	(*SimMetric)(nil),              // 5: k9sautoscaler.providers.metrics.proto.SimMetric
+	(*AutoscalerConfig)(nil),       // 6: k9sautoscaler.providers.metrics.proto.AutoscalerConfig
+	(*SimScalingConfig)(nil),       // 7: k9sautoscaler.providers.metrics.proto.SimScalingConfig
+	(*SimConfig)(nil),              // 8: k9sautoscaler.providers.metrics.proto.SimConfig
+	(*SimMetricConfig)(nil),        // 9: k9sautoscaler.providers.metrics.proto.SimMetricConfig
+	(*SimScalingTargetConfig)(nil), // 10: k9sautoscaler.providers.metrics.proto.SimScalingTargetConfig
+	(*durationpb.Duration)(nil),    // 11: google.protobuf.Duration
 }
 var file_sim_proto_depIdxs = []int32{
-	5, // 0: k9sautoscaler.providers.metrics.proto.MetricLoad.timespan:type_name -> google.protobuf.Duration
-	0, // 1: k9sautoscaler.providers.metrics.proto.AutoscalerConfig.load:type_name -> k9sautoscaler.providers.metrics.proto.MetricLoad
-	1, // 2: k9sautoscaler.providers.metrics.proto.SimConfig.autoscalers_config:type_name -> k9sautoscaler.providers.metrics.proto.AutoscalerConfig
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: k9sautoscaler.providers.metrics.proto.MetricLoad.timespan:type_name -> google.protobuf.Duration
+	0,  // 1: k9sautoscaler.providers.metrics.proto.MetricLoad.interpolation:type_name -> k9sautoscaler.providers.metrics.proto.MetricLoad.Interpolation
+	11, // 2: k9sautoscaler.providers.metrics.proto.MetricLoad.period:type_name -> google.protobuf.Duration
+	11, // 3: k9sautoscaler.providers.metrics.proto.MetricLoad.phase:type_name -> google.protobuf.Duration
+	2,  // 4: k9sautoscaler.providers.metrics.proto.MetricLoad.trace:type_name -> k9sautoscaler.providers.metrics.proto.LoadTrace
+	3,  // 5: k9sautoscaler.providers.metrics.proto.MetricLoad.burst:type_name -> k9sautoscaler.providers.metrics.proto.LoadBurst
+	4,  // 6: k9sautoscaler.providers.metrics.proto.MetricLoad.noise:type_name -> k9sautoscaler.providers.metrics.proto.LoadNoise
+	0,  // 7: k9sautoscaler.providers.metrics.proto.LoadTrace.interpolation:type_name -> k9sautoscaler.providers.metrics.proto.MetricLoad.Interpolation
+	11, // 8: k9sautoscaler.providers.metrics.proto.LoadBurst.duration:type_name -> google.protobuf.Duration
+	11, // 9: k9sautoscaler.providers.metrics.proto.LoadBurst.every:type_name -> google.protobuf.Duration
+	11, // 10: k9sautoscaler.providers.metrics.proto.LoadNoise.interval:type_name -> google.protobuf.Duration
+	1,  // 11: k9sautoscaler.providers.metrics.proto.SimMetric.load:type_name -> k9sautoscaler.providers.metrics.proto.MetricLoad
+	1,  // 12: k9sautoscaler.providers.metrics.proto.AutoscalerConfig.load:type_name -> k9sautoscaler.providers.metrics.proto.MetricLoad
+	5,  // 13: k9sautoscaler.providers.metrics.proto.AutoscalerConfig.metrics:type_name -> k9sautoscaler.providers.metrics.proto.SimMetric
+	11, // 14: k9sautoscaler.providers.metrics.proto.SimScalingConfig.provisioning_delay:type_name -> google.protobuf.Duration
+	6,  // 15: k9sautoscaler.providers.metrics.proto.SimConfig.autoscalers_config:type_name -> k9sautoscaler.providers.metrics.proto.AutoscalerConfig
+	7,  // 16: k9sautoscaler.providers.metrics.proto.SimConfig.scaling:type_name -> k9sautoscaler.providers.metrics.proto.SimScalingConfig
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_sim_proto_init() }
@@ -389,7 +1000,7 @@ func file_sim_proto_init() {
 			}
 		}
 		file_sim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoscalerConfig); i {
+			switch v := v.(*LoadTrace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -401,7 +1012,7 @@ func file_sim_proto_init() {
 			}
 		}
 		file_sim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimConfig); i {
+			switch v := v.(*LoadBurst); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -413,7 +1024,7 @@ func file_sim_proto_init() {
 			}
 		}
 		file_sim_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimMetricConfig); i {
+			switch v := v.(*LoadNoise); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -425,6 +1036,66 @@ func file_sim_proto_init() {
 			}
 		}
 		file_sim_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoscalerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimScalingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimMetricConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sim_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SimScalingTargetConfig); i {
 			case 0:
 				return &v.state
@@ -437,18 +1108,23 @@ func file_sim_proto_init() {
 			}
 		}
 	}
+	file_sim_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_sim_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_sim_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_sim_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sim_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sim_proto_goTypes,
 		DependencyIndexes: file_sim_proto_depIdxs,
+		EnumInfos:         file_sim_proto_enumTypes,
 		MessageInfos:      file_sim_proto_msgTypes,
 	}.Build()
 	File_sim_proto = out.File
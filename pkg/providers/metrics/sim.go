@@ -5,6 +5,8 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
 	"time"
 
 	metricstypes "k9s-autoscaler/pkg/metrics/types"
@@ -16,163 +18,369 @@ import (
 	protob "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 )
 
-var (
-	simSingleton *metricsSim = nil
+const (
+	defaultSimInitialScale = 1
 )
 
+// Simulated state of an autoscaler.
 type autoscalerState struct {
-	totalLoadTimespan    time.Duration
-	currentInstanceCount int32
+	metrics map[string]*simMetric
+	scale   *simScale
+}
+
+// A simulated metric with its load schedule.
+type simMetric struct {
+	maxLoadPerInstance float64
+	loads              []*simLoad
+	totalLoadTimespan  time.Duration
+}
+
+// Simulated scale where scaling up takes effect after a provisioning delay.
+type simScale struct {
+	desired int32
+	current int32
+	// scale ups that are being provisioned, ordered by ready time.
+	pending []simPendingScale
+}
+
+type simPendingScale struct {
+	readyTime time.Time
+	scale     int32
 }
 
 // Simulation metrics provider adapter for testing. It also provides a scaling
 // provider adapter such that when scale changes it can recaculate the load
 // for metrics.
-// It implements a single metric that returns the average load as a percentage.
+// Each metric returns the average load per current instance as a percentage.
+// It is safe for concurrent use.
 // see pkg/providers/metrics/proto/sim.proto
 type metricsSim struct {
-	config                           *proto.SimConfig
-	startTime                        time.Time
+	scaling   *proto.SimScalingConfig
+	clock     clock.PassiveClock
+	startTime time.Time
+	// protected by state lock.
+	random *rand.Rand
+	// may be shared with scaling sims of other scaling options.
+	state *simState
+}
+
+// State of all simulated autoscalers.
+type simState struct {
+	// protects fields below.
+	lock                             sync.Mutex
 	autoscalerStateByNamespaceByName map[string]map[string]*autoscalerState
-	initialized                      bool
 }
 
+type simFactory struct{}
+
 func init() {
-	providers.RegisterMetricsClient(&proto.SimConfig{}, &metricsSim{})
-	providers.RegisterScalingClient(&proto.SimConfig{}, &proto.SimScalingTargetConfig{}, &metricsSim{})
+	providers.RegisterMetricsClient(&proto.SimConfig{}, &proto.SimMetricConfig{}, &simFactory{})
+	providers.RegisterScalingClient(&proto.SimConfig{}, &proto.SimScalingTargetConfig{}, &simFactory{})
 }
 
-func (s *metricsSim) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
-	if s.initialized {
-		return s, nil
+func (f *simFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	simConfig := proto.SimConfig{}
+	if err := anypb.UnmarshalTo(config, &simConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
 	}
+	if len(simConfig.AutoscalersConfig) == 0 {
+		return nil, fmt.Errorf("no autoscaler configurations specified")
+	}
+
+	return newMetricsSim(&simConfig, clock.RealClock{})
+}
 
+// Returns the sim metrics client if config is empty. If config has scaling
+// options, returns a scaling simulation with these options that shares state
+// of the sim metrics client, or a standalone one if there is no sim metrics
+// client.
+func (f *simFactory) ScalingClient(config *anypb.Any) (scalingtypes.ScalingClient, error) {
 	simConfig := proto.SimConfig{}
 	if err := anypb.UnmarshalTo(config, &simConfig, protob.UnmarshalOptions{}); err != nil {
 		return nil, err
 	}
 
-	if len(simConfig.MetricName) == 0 {
-		return nil, fmt.Errorf("metric name must be provided")
+	metricConfig, err := anypb.New(&proto.SimMetricConfig{})
+	if err != nil {
+		return nil, err
 	}
-	if len(simConfig.AutoscalersConfig) == 0 {
-		return nil, fmt.Errorf("no autoscaler configurations specified")
+	client, err := providers.MetricsClientByMetricConfig(metricConfig)
+	if err != nil {
+		if simConfig.Scaling != nil {
+			return newMetricsSim(&simConfig, clock.RealClock{})
+		}
+		return nil, fmt.Errorf("metrics sim has not been initialized or not configured: %v", err)
+	}
+	sim, ok := client.(*metricsSim)
+	if !ok {
+		return nil, fmt.Errorf("unexpected metrics sim client %T", client)
+	}
+	if simConfig.Scaling != nil {
+		return sim.withScaling(simConfig.Scaling)
 	}
 
-	s.autoscalerStateByNamespaceByName = make(map[string]map[string]*autoscalerState)
-	for _, config := range simConfig.AutoscalersConfig {
-		if len(config.AutoscalerName) == 0 {
-			return nil, fmt.Errorf("autoscaler name cannot be empty for autoscaler %s namespace %s", config.AutoscalerName, config.AutoscalerNamespace)
-		}
-		if config.MaxLoadPerInstance <= 0 {
-			return nil, fmt.Errorf("max load per instance must be > 0 for autoscaler %s namespace %s", config.AutoscalerName, config.AutoscalerNamespace)
-		}
-		if len(config.Load) == 0 {
-			return nil, fmt.Errorf("no load specified to autoscaler %s namespace %s", config.AutoscalerName, config.AutoscalerNamespace)
-		}
-		totalTimespan := time.Duration(0)
-		for _, load := range config.Load {
-			if load.Timespan.AsDuration() <= time.Duration(0) {
-				return nil, fmt.Errorf("timespan cannot be 0 for autoscaler %s namespace %s", config.AutoscalerName, config.AutoscalerNamespace)
-			}
-			totalTimespan += load.Timespan.AsDuration()
-		}
-		if _, ok := s.autoscalerStateByNamespaceByName[config.AutoscalerNamespace]; !ok {
-			s.autoscalerStateByNamespaceByName[config.AutoscalerNamespace] = make(map[string]*autoscalerState)
+	return sim, nil
+}
+
+// Create a new sim from config that uses clock to track time.
+func newMetricsSim(config *proto.SimConfig, clock clock.PassiveClock) (*metricsSim, error) {
+	scaling := config.Scaling
+	if scaling == nil {
+		scaling = &proto.SimScalingConfig{}
+	}
+	if err := validateSimScaling(scaling); err != nil {
+		return nil, err
+	}
+
+	s := &metricsSim{
+		scaling:   scaling,
+		clock:     clock,
+		startTime: clock.Now(),
+		random:    rand.New(rand.NewSource(scaling.Seed)),
+		state: &simState{
+			autoscalerStateByNamespaceByName: make(map[string]map[string]*autoscalerState),
+		},
+	}
+	for _, autoscalerConfig := range config.AutoscalersConfig {
+		if len(autoscalerConfig.AutoscalerName) == 0 {
+			return nil, fmt.Errorf("autoscaler name cannot be empty for autoscaler %s namespace %s", autoscalerConfig.AutoscalerName, autoscalerConfig.AutoscalerNamespace)
 		}
-		s.autoscalerStateByNamespaceByName[config.AutoscalerNamespace][config.AutoscalerName] = &autoscalerState{
-			totalLoadTimespan:    totalTimespan,
-			currentInstanceCount: 1,
+		metrics, err := newSimMetrics(config.MetricName, autoscalerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("autoscaler %s namespace %s: %v", autoscalerConfig.AutoscalerName, autoscalerConfig.AutoscalerNamespace, err)
 		}
+		s.getStateLocked(autoscalerConfig.AutoscalerName, autoscalerConfig.AutoscalerNamespace).metrics = metrics
+	}
+
+	return s, nil
+}
+
+// Returns a scaling sim with scaling options that shares state of s, such that
+// its scale changes feed back into metrics of s. Initial scale, if set, applies
+// to all autoscalers of s.
+func (s *metricsSim) withScaling(scaling *proto.SimScalingConfig) (*metricsSim, error) {
+	if err := validateSimScaling(scaling); err != nil {
+		return nil, err
 	}
 
-	s.config = &simConfig
-	s.startTime = time.Now()
-	s.initialized = true
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
 
-	simSingleton = s
+	if scaling.InitialScale != nil {
+		for _, autoscalers := range s.state.autoscalerStateByNamespaceByName {
+			for _, state := range autoscalers {
+				state.scale = &simScale{desired: *scaling.InitialScale, current: *scaling.InitialScale}
+			}
+		}
+	}
 
-	return s, nil
+	return &metricsSim{
+		scaling:   scaling,
+		clock:     s.clock,
+		startTime: s.startTime,
+		random:    rand.New(rand.NewSource(scaling.Seed)),
+		state:     s.state,
+	}, nil
 }
 
-func (s *metricsSim) ScalingClient(config *anypb.Any) (scalingtypes.ScalingClient, error) {
-	if simSingleton == nil {
-		return nil, fmt.Errorf("metrics sim has not been initialized or not configured")
+func validateSimScaling(scaling *proto.SimScalingConfig) error {
+	if scaling.ProvisioningDelay.AsDuration() < 0 {
+		return fmt.Errorf("provisioning delay must be >= 0")
+	}
+	if scaling.FailureRate < 0 || scaling.FailureRate > 1 {
+		return fmt.Errorf("failure rate must be within [0, 1]")
+	}
+	if scaling.InitialScale != nil && *scaling.InitialScale < 0 {
+		return fmt.Errorf("initial scale must be >= 0")
 	}
 
-	return simSingleton, nil
+	return nil
 }
 
-func (s *metricsSim) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]int64, time.Time, error) {
-	if metricName != s.config.MetricName {
-		return nil, time.Time{}, fmt.Errorf("invalid metric name: %s != %s", metricName, s.config.MetricName)
+// Creates metrics of an autoscaler from its load and additional metrics.
+func newSimMetrics(metricName string, config *proto.AutoscalerConfig) (map[string]*simMetric, error) {
+	metricConfigs := config.Metrics
+	if len(config.Load) > 0 {
+		if len(metricName) == 0 {
+			return nil, fmt.Errorf("metric name must be provided")
+		}
+		metricConfigs = append([]*proto.SimMetric{{Name: metricName, Load: config.Load}}, metricConfigs...)
+	}
+	if len(metricConfigs) == 0 {
+		return nil, fmt.Errorf("no load specified")
 	}
 
-	for _, autoscalerConfig := range s.config.AutoscalersConfig {
-		if autoscalerConfig.AutoscalerNamespace != namespace || autoscalerConfig.AutoscalerName != autoscalerName {
-			continue
+	metrics := make(map[string]*simMetric)
+	for _, metricConfig := range metricConfigs {
+		if _, ok := metrics[metricConfig.Name]; ok {
+			return nil, fmt.Errorf("duplicate metric %s", metricConfig.Name)
 		}
-		var state *autoscalerState
-		if autoscalers, ok := s.autoscalerStateByNamespaceByName[namespace]; !ok {
-			return nil, time.Time{}, fmt.Errorf("no autoscaler found namespace %s", namespace)
-		} else if state, ok = autoscalers[autoscalerName]; !ok {
-			return nil, time.Time{}, fmt.Errorf("autoscaler not found namespace %s name %s", namespace, autoscalerName)
+		metric := &simMetric{
+			maxLoadPerInstance: metricConfig.MaxLoadPerInstance,
 		}
-
-		delta := time.Since(s.startTime) % state.totalLoadTimespan
-		currentOffset := time.Duration(0)
-		for _, load := range autoscalerConfig.Load {
-			if currentOffset+load.Timespan.AsDuration() > delta {
-				values := []int64{}
-				// calculate load percentage
-				if state.currentInstanceCount > 0 {
-					value := int64(100 * (load.Load / float64(state.currentInstanceCount)) / autoscalerConfig.MaxLoadPerInstance)
-					// value should be in millis
-					value *= 1000
-					values = append(values, value)
-				}
-				klog.V(10).InfoS("returning metric", "values", values)
-				return values, time.Now(), nil
+		if metric.maxLoadPerInstance == 0 {
+			metric.maxLoadPerInstance = config.MaxLoadPerInstance
+		}
+		if metric.maxLoadPerInstance <= 0 {
+			return nil, fmt.Errorf("max load per instance must be > 0 for metric %s", metricConfig.Name)
+		}
+		if len(metricConfig.Load) == 0 {
+			return nil, fmt.Errorf("no load specified for metric %s", metricConfig.Name)
+		}
+		for i, loadConfig := range metricConfig.Load {
+			load, err := newSimLoad(loadConfig)
+			if err != nil {
+				return nil, fmt.Errorf("invalid load %d for metric %s: %v", i, metricConfig.Name, err)
 			}
-			currentOffset += load.Timespan.AsDuration()
+			metric.loads = append(metric.loads, load)
+			metric.totalLoadTimespan += load.timespan
 		}
-
-		// shouldn't happen
-		panic("expected to find load")
+		metrics[metricConfig.Name] = metric
 	}
 
-	return nil, time.Time{}, fmt.Errorf("autoscaler %s namespace %s not found", autoscalerName, namespace)
+	return metrics, nil
 }
 
-func (s *metricsSim) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+// Simulated metrics are per autoscaler.
+func (s *metricsSim) AutoscalerScoped(config *anypb.Any) bool {
+	return true
+}
+
+func (s *metricsSim) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
+
 	var state *autoscalerState
-	if autoscalers, ok := s.autoscalerStateByNamespaceByName[namespace]; !ok {
-		return fmt.Errorf("no autoscaler found namespace %s", namespace)
-	} else if state, ok = autoscalers[name]; !ok {
-		return fmt.Errorf("autoscaler not found namespace %s name %s", namespace, name)
+	if autoscalers, ok := s.state.autoscalerStateByNamespaceByName[namespace]; !ok {
+		return nil, time.Time{}, fmt.Errorf("no autoscaler found namespace %s", namespace)
+	} else if state, ok = autoscalers[autoscalerName]; !ok || state.metrics == nil {
+		return nil, time.Time{}, fmt.Errorf("autoscaler %s namespace %s not found", autoscalerName, namespace)
 	}
+	metric, ok := state.metrics[metricName]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("invalid metric name %s for autoscaler %s namespace %s", metricName, autoscalerName, namespace)
+	}
+
+	now := s.clock.Now()
+	currentInstanceCount := state.scale.update(now)
+	elapsed := now.Sub(s.startTime)
+	delta := elapsed % metric.totalLoadTimespan
+	currentOffset := time.Duration(0)
+	for _, load := range metric.loads {
+		if currentOffset+load.timespan > delta {
+			values := []float64{}
+			// calculate load percentage
+			if currentInstanceCount > 0 {
+				totalLoad := load.loadAt(delta-currentOffset, elapsed)
+				value := 100 * (totalLoad / float64(currentInstanceCount)) / metric.maxLoadPerInstance
+				values = append(values, value)
+			}
+			klog.V(10).InfoS("returning metric", "values", values)
+			return values, now, nil
+		}
+		currentOffset += load.timespan
+	}
+
+	// shouldn't happen
+	panic("expected to find load")
+}
+
+func (s *metricsSim) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
 
-	state.currentInstanceCount = target.Desired
+	if err := s.injectFailureLocked("set", name, namespace); err != nil {
+		return err
+	}
+	s.getStateLocked(name, namespace).scale.set(target.Desired, s.clock.Now(), s.scaling.ProvisioningDelay.AsDuration())
 
 	return nil
 }
 
 func (s *metricsSim) GetScale(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
-	var state *autoscalerState
-	if autoscalers, ok := s.autoscalerStateByNamespaceByName[namespace]; !ok {
-		return nil, fmt.Errorf("no autoscaler found namespace %s", namespace)
-	} else if state, ok = autoscalers[name]; !ok {
-		return nil, fmt.Errorf("autoscaler not found namespace %s name %s", namespace, name)
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
+
+	if err := s.injectFailureLocked("get", name, namespace); err != nil {
+		return nil, err
 	}
+	scale := s.getStateLocked(name, namespace).scale
+	current := scale.update(s.clock.Now())
 
 	return &prototypes.Scale{
 		Spec: &prototypes.ScaleSpec{
-			Desired: state.currentInstanceCount,
+			Desired: scale.desired,
 		},
 		Status: &prototypes.ScaleStatus{
-			Current: state.currentInstanceCount,
+			Current: current,
 		},
 	}, nil
 }
+
+// Returns an error with probability of configured failure rate.
+func (s *metricsSim) injectFailureLocked(op, name, namespace string) error {
+	if s.scaling.FailureRate > 0 && s.random.Float64() < s.scaling.FailureRate {
+		return fmt.Errorf("injected %s scale failure for autoscaler %s namespace %s", op, name, namespace)
+	}
+
+	return nil
+}
+
+// Returns state of an autoscaler, creating it with initial scale if needed.
+func (s *metricsSim) getStateLocked(name, namespace string) *autoscalerState {
+	if _, ok := s.state.autoscalerStateByNamespaceByName[namespace]; !ok {
+		s.state.autoscalerStateByNamespaceByName[namespace] = make(map[string]*autoscalerState)
+	}
+	state, ok := s.state.autoscalerStateByNamespaceByName[namespace][name]
+	if !ok {
+		initialScale := int32(defaultSimInitialScale)
+		if s.scaling.InitialScale != nil {
+			initialScale = *s.scaling.InitialScale
+		}
+		state = &autoscalerState{
+			scale: &simScale{desired: initialScale, current: initialScale},
+		}
+		s.state.autoscalerStateByNamespaceByName[namespace][name] = state
+	}
+
+	return state
+}
+
+// Sets desired scale at now. Scaling up is pending for delay while scaling
+// down is immediate.
+func (s *simScale) set(desired int32, now time.Time, delay time.Duration) {
+	s.update(now)
+	s.desired = desired
+	if desired <= s.current {
+		s.current = desired
+		s.pending = nil
+		return
+	}
+	// pending scale ups cannot exceed new desired scale
+	for i := range s.pending {
+		if s.pending[i].scale > desired {
+			s.pending[i].scale = desired
+		}
+	}
+	if delay == 0 {
+		s.current = desired
+		s.pending = nil
+		return
+	}
+	s.pending = append(s.pending, simPendingScale{readyTime: now.Add(delay), scale: desired})
+}
+
+// Applies pending scale ups that are ready at now and returns current scale.
+func (s *simScale) update(now time.Time) int32 {
+	for len(s.pending) > 0 && !now.Before(s.pending[0].readyTime) {
+		if s.pending[0].scale > s.current {
+			s.current = s.pending[0].scale
+		}
+		s.pending = s.pending[1:]
+	}
+
+	return s.current
+}
@@ -4,15 +4,19 @@ package metrics
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/providers"
 	"k9s-autoscaler/pkg/providers/metrics/proto"
+	configproto "k9s-autoscaler/pkg/providers/proto"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	testingclock "k8s.io/utils/clock/testing"
 )
 
 func TestMetricsSimSimple(t *testing.T) {
@@ -38,7 +42,7 @@ func TestMetricsSimSimple(t *testing.T) {
 	}
 	configAny, err := anypb.New(&config)
 	require.NoError(t, err)
-	client, err := (&metricsSim{}).MetricsClient(configAny)
+	client, err := (&simFactory{}).MetricsClient(configAny)
 	require.NoError(t, err)
 	sim := client.(*metricsSim)
 
@@ -53,13 +57,13 @@ func TestMetricsSimSimple(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, values, 1)
 	// 200%
-	require.EqualValues(t, 200000, values[0])
+	require.EqualValues(t, 200, values[0])
 	time.Sleep(100 * time.Millisecond)
 	values, _, err = client.GetMetric(context.Background(), t.Name(), t.Name(), "testnamespace", nil)
 	require.NoError(t, err)
 	require.Len(t, values, 1)
 	// 400%
-	require.EqualValues(t, 400000, values[0])
+	require.EqualValues(t, 400, values[0])
 
 	// back from the start
 	time.Sleep(200 * time.Millisecond)
@@ -67,5 +71,188 @@ func TestMetricsSimSimple(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, values, 1)
 	// 200%
-	require.EqualValues(t, 200000, values[0])
+	require.EqualValues(t, 200, values[0])
+}
+
+func TestMetricsSimMultipleMetrics(t *testing.T) {
+	clock := testingclock.NewFakePassiveClock(time.Now())
+	sim, err := newMetricsSim(&proto.SimConfig{
+		MetricName: "load",
+		AutoscalersConfig: []*proto.AutoscalerConfig{
+			{
+				AutoscalerName:      "testas",
+				AutoscalerNamespace: "testns",
+				MaxLoadPerInstance:  50,
+				Load:                []*proto.MetricLoad{{Timespan: durationpb.New(time.Minute), Load: 100}},
+				Metrics: []*proto.SimMetric{
+					{
+						Name:               "queue",
+						MaxLoadPerInstance: 10,
+						Load:               []*proto.MetricLoad{{Timespan: durationpb.New(time.Minute), Load: 5}},
+					},
+				},
+			},
+		},
+	}, clock)
+	require.NoError(t, err)
+
+	values, _, err := sim.GetMetric(context.Background(), "load", "testas", "testns", nil)
+	require.NoError(t, err)
+	require.Equal(t, []float64{200}, values)
+	values, _, err = sim.GetMetric(context.Background(), "queue", "testas", "testns", nil)
+	require.NoError(t, err)
+	require.Equal(t, []float64{50}, values)
+	_, _, err = sim.GetMetric(context.Background(), "other", "testas", "testns", nil)
+	require.Error(t, err)
+	_, _, err = sim.GetMetric(context.Background(), "load", "otheras", "testns", nil)
+	require.Error(t, err)
+
+	// concurrent scaling and metrics
+	wg := sync.WaitGroup{}
+	for i := 1; i <= 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := sim.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: int32(i)})
+			require.NoError(t, err)
+			_, _, err = sim.GetMetric(context.Background(), "load", "testas", "testns", nil)
+			require.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	// invalid
+	for _, config := range []*proto.AutoscalerConfig{
+		{AutoscalerName: "testas", MaxLoadPerInstance: 1},
+		{AutoscalerName: "testas", Metrics: []*proto.SimMetric{{Name: "m", Load: []*proto.MetricLoad{{Timespan: durationpb.New(time.Second)}}}}},
+		{AutoscalerName: "testas", MaxLoadPerInstance: 1, Metrics: []*proto.SimMetric{
+			{Name: "m", Load: []*proto.MetricLoad{{Timespan: durationpb.New(time.Second)}}},
+			{Name: "m", Load: []*proto.MetricLoad{{Timespan: durationpb.New(time.Second)}}},
+		}},
+	} {
+		_, err := newMetricsSim(&proto.SimConfig{AutoscalersConfig: []*proto.AutoscalerConfig{config}}, clock)
+		require.Error(t, err, config)
+	}
+}
+
+func TestMetricsSimProvisioningDelay(t *testing.T) {
+	clock := testingclock.NewFakePassiveClock(time.Now())
+	initialScale := int32(2)
+	sim, err := newMetricsSim(&proto.SimConfig{
+		MetricName: "load",
+		AutoscalersConfig: []*proto.AutoscalerConfig{
+			{
+				AutoscalerName:      "testas",
+				AutoscalerNamespace: "testns",
+				MaxLoadPerInstance:  10,
+				Load:                []*proto.MetricLoad{{Timespan: durationpb.New(time.Hour), Load: 80}},
+			},
+		},
+		Scaling: &proto.SimScalingConfig{
+			ProvisioningDelay: durationpb.New(time.Minute),
+			InitialScale:      &initialScale,
+		},
+	}, clock)
+	require.NoError(t, err)
+	getScale := func() (int32, int32) {
+		scale, err := sim.GetScale(context.Background(), "testas", "testns", nil)
+		require.NoError(t, err)
+		return scale.Spec.Desired, scale.Status.Current
+	}
+	getMetric := func() float64 {
+		values, _, err := sim.GetMetric(context.Background(), "load", "testas", "testns", nil)
+		require.NoError(t, err)
+		return values[0]
+	}
+
+	desired, current := getScale()
+	require.Equal(t, int32(2), desired)
+	require.Equal(t, int32(2), current)
+	require.Equal(t, 400.0, getMetric())
+
+	// scaling up lags by provisioning delay
+	err = sim.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: 4})
+	require.NoError(t, err)
+	clock.SetTime(clock.Now().Add(30 * time.Second))
+	err = sim.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: 8})
+	require.NoError(t, err)
+	desired, current = getScale()
+	require.Equal(t, int32(8), desired)
+	require.Equal(t, int32(2), current)
+	require.Equal(t, 400.0, getMetric())
+	clock.SetTime(clock.Now().Add(30 * time.Second))
+	_, current = getScale()
+	require.Equal(t, int32(4), current)
+	require.Equal(t, 200.0, getMetric())
+	clock.SetTime(clock.Now().Add(30 * time.Second))
+	_, current = getScale()
+	require.Equal(t, int32(8), current)
+
+	// scaling down is immediate and caps pending scale ups
+	err = sim.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: 16})
+	require.NoError(t, err)
+	err = sim.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: 1})
+	require.NoError(t, err)
+	clock.SetTime(clock.Now().Add(time.Hour))
+	desired, current = getScale()
+	require.Equal(t, int32(1), desired)
+	require.Equal(t, int32(1), current)
+}
+
+func TestMetricsSimScalingOnly(t *testing.T) {
+	config, err := anypb.New(&proto.SimConfig{
+		Scaling: &proto.SimScalingConfig{FailureRate: 0.5, Seed: 1},
+	})
+	require.NoError(t, err)
+	client, err := (&simFactory{}).ScalingClient(config)
+	require.NoError(t, err)
+
+	// any autoscaler can be scaled, and about half of the calls fail
+	failures := 0
+	for i := 0; i < 1000; i++ {
+		if err := client.SetScaleTarget(context.Background(), "testas", "testns", nil, &prototypes.ScaleSpec{Desired: 3}); err != nil {
+			failures++
+		}
+	}
+	require.InDelta(t, 500, failures, 100)
+
+	_, err = (&simFactory{}).ScalingClient(mustAny(t, &proto.SimConfig{Scaling: &proto.SimScalingConfig{FailureRate: 2}}))
+	require.Error(t, err)
+}
+
+func TestMetricsSimScalingClient(t *testing.T) {
+	metricsConfig := mustAny(t, &proto.SimConfig{
+		MetricName: "load",
+		AutoscalersConfig: []*proto.AutoscalerConfig{
+			{
+				AutoscalerName:     "testas",
+				MaxLoadPerInstance: 10,
+				Load:               []*proto.MetricLoad{{Timespan: durationpb.New(time.Hour), Load: 10}},
+			},
+		},
+	})
+	metricsClient, err := providers.MetricsClient(&configproto.ProviderConfig{Config: metricsConfig})
+	require.NoError(t, err)
+
+	// empty scaling config uses the metrics sim
+	scalingClient, err := (&simFactory{}).ScalingClient(mustAny(t, &proto.SimConfig{}))
+	require.NoError(t, err)
+	require.Same(t, metricsClient, scalingClient)
+
+	// scaling options share state of the metrics sim
+	initialScale := int32(5)
+	scalingClient, err = (&simFactory{}).ScalingClient(mustAny(t, &proto.SimConfig{
+		Scaling: &proto.SimScalingConfig{InitialScale: &initialScale},
+	}))
+	require.NoError(t, err)
+	require.NotSame(t, metricsClient, scalingClient)
+	getMetric := func() float64 {
+		values, _, err := metricsClient.GetMetric(context.Background(), "load", "testas", "", nil)
+		require.NoError(t, err)
+		return values[0]
+	}
+	require.Equal(t, 20.0, getMetric())
+	err = scalingClient.SetScaleTarget(context.Background(), "testas", "", nil, &prototypes.ScaleSpec{Desired: 2})
+	require.NoError(t, err)
+	require.Equal(t, 50.0, getMetric())
 }
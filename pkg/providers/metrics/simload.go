@@ -0,0 +1,116 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+	"k9s-autoscaler/pkg/timeseries"
+)
+
+const (
+	defaultSimNoiseInterval = time.Second
+)
+
+// A validated sim load entry.
+type simLoad struct {
+	config   *proto.MetricLoad
+	timespan time.Duration
+	period   time.Duration
+	trace    []timeseries.Point
+}
+
+// Validates config and loads its trace if any.
+func newSimLoad(config *proto.MetricLoad) (*simLoad, error) {
+	load := &simLoad{
+		config:   config,
+		timespan: config.Timespan.AsDuration(),
+	}
+	switch config.Interpolation {
+	case proto.MetricLoad_Step, proto.MetricLoad_Linear:
+	case proto.MetricLoad_Sine:
+		load.period = load.timespan
+		if config.Period != nil {
+			load.period = config.Period.AsDuration()
+		}
+		if load.period <= 0 {
+			return nil, fmt.Errorf("sine period must be > 0")
+		}
+	case proto.MetricLoad_Trace:
+		if config.Trace == nil {
+			return nil, fmt.Errorf("trace is required")
+		}
+		if config.Trace.Interpolation != proto.MetricLoad_Step && config.Trace.Interpolation != proto.MetricLoad_Linear {
+			return nil, fmt.Errorf("unsupported trace interpolation %v", config.Trace.Interpolation)
+		}
+		trace, err := timeseries.Read(config.Trace.File, "load")
+		if err != nil {
+			return nil, err
+		}
+		load.trace = trace
+		if config.Timespan == nil {
+			load.timespan = trace[len(trace)-1].Offset
+		}
+	default:
+		return nil, fmt.Errorf("unknown interpolation %v", config.Interpolation)
+	}
+	if load.timespan <= 0 {
+		return nil, fmt.Errorf("timespan must be > 0")
+	}
+	if burst := config.Burst; burst != nil {
+		if burst.Duration.AsDuration() <= 0 || burst.Every.AsDuration() <= 0 {
+			return nil, fmt.Errorf("burst duration and every must be > 0")
+		}
+	}
+	if noise := config.Noise; noise != nil {
+		if noise.Stddev < 0 {
+			return nil, fmt.Errorf("noise stddev must be >= 0")
+		}
+		if noise.Interval != nil && noise.Interval.AsDuration() <= 0 {
+			return nil, fmt.Errorf("noise interval must be > 0")
+		}
+	}
+
+	return load, nil
+}
+
+// Returns load at offset from the start of this entry. elapsed is time since
+// start of the simulation, which keeps noise different across iterations of
+// the load schedule. Load is never negative.
+func (l *simLoad) loadAt(offset, elapsed time.Duration) float64 {
+	config := l.config
+	value := config.Load
+	switch config.Interpolation {
+	case proto.MetricLoad_Linear:
+		value = config.Load + (config.EndLoad-config.Load)*float64(offset)/float64(l.timespan)
+	case proto.MetricLoad_Sine:
+		phase := offset + config.Phase.AsDuration()
+		value = config.Load + config.Amplitude*math.Sin(2*math.Pi*float64(phase)/float64(l.period))
+	case proto.MetricLoad_Trace:
+		value = l.traceLoadAt(offset)
+	}
+
+	if burst := config.Burst; burst != nil && offset%burst.Every.AsDuration() < burst.Duration.AsDuration() {
+		value += burst.Load
+	}
+	if noise := config.Noise; noise != nil && noise.Stddev > 0 {
+		interval := defaultSimNoiseInterval
+		if noise.Interval != nil {
+			interval = noise.Interval.AsDuration()
+		}
+		bucket := int64(elapsed / interval)
+		random := rand.New(rand.NewSource(noise.Seed ^ (bucket * 0x5DEECE66D)))
+		value += random.NormFloat64() * noise.Stddev
+	}
+
+	return math.Max(0, value)
+}
+
+// Returns trace load at offset. Offsets past the last point hold its load.
+func (l *simLoad) traceLoadAt(offset time.Duration) float64 {
+	return timeseries.ValueAt(l.trace, offset, l.config.Trace.Interpolation == proto.MetricLoad_Linear)
+}
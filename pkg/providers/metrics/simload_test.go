@@ -0,0 +1,139 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/providers/metrics/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSimLoadShapes(t *testing.T) {
+	loadAt := func(config *proto.MetricLoad, offset time.Duration) float64 {
+		load, err := newSimLoad(config)
+		require.NoError(t, err)
+		return load.loadAt(offset, offset)
+	}
+
+	step := &proto.MetricLoad{Timespan: durationpb.New(time.Minute), Load: 10}
+	require.Equal(t, 10.0, loadAt(step, 0))
+	require.Equal(t, 10.0, loadAt(step, 59*time.Second))
+
+	linear := &proto.MetricLoad{
+		Timespan:      durationpb.New(100 * time.Second),
+		Load:          10,
+		EndLoad:       110,
+		Interpolation: proto.MetricLoad_Linear,
+	}
+	require.Equal(t, 10.0, loadAt(linear, 0))
+	require.Equal(t, 35.0, loadAt(linear, 25*time.Second))
+	require.Equal(t, 100.0, loadAt(linear, 90*time.Second))
+
+	sine := &proto.MetricLoad{
+		Timespan:      durationpb.New(time.Hour),
+		Load:          100,
+		Amplitude:     50,
+		Period:        durationpb.New(4 * time.Minute),
+		Interpolation: proto.MetricLoad_Sine,
+	}
+	require.InDelta(t, 100, loadAt(sine, 0), 1e-9)
+	require.InDelta(t, 150, loadAt(sine, time.Minute), 1e-9)
+	require.InDelta(t, 50, loadAt(sine, 3*time.Minute), 1e-9)
+	sine.Phase = durationpb.New(time.Minute)
+	require.InDelta(t, 150, loadAt(sine, 0), 1e-9)
+
+	// load is never negative
+	sine.Amplitude = 200
+	require.Equal(t, 0.0, loadAt(sine, 2*time.Minute))
+
+	burst := &proto.MetricLoad{
+		Timespan: durationpb.New(time.Hour),
+		Load:     10,
+		Burst: &proto.LoadBurst{
+			Load:     90,
+			Duration: durationpb.New(10 * time.Second),
+			Every:    durationpb.New(time.Minute),
+		},
+	}
+	require.Equal(t, 100.0, loadAt(burst, 5*time.Second))
+	require.Equal(t, 10.0, loadAt(burst, 30*time.Second))
+	require.Equal(t, 100.0, loadAt(burst, 65*time.Second))
+
+	// noise is a function of seed and time
+	noisy := &proto.MetricLoad{
+		Timespan: durationpb.New(time.Hour),
+		Load:     100,
+		Noise:    &proto.LoadNoise{Stddev: 10, Seed: 42},
+	}
+	values := map[float64]bool{}
+	for i := 0; i < 10; i++ {
+		offset := time.Duration(i) * time.Second
+		value := loadAt(noisy, offset)
+		require.Equal(t, value, loadAt(noisy, offset+500*time.Millisecond))
+		values[value] = true
+	}
+	require.Greater(t, len(values), 1)
+	other := loadAt(&proto.MetricLoad{
+		Timespan: durationpb.New(time.Hour),
+		Load:     100,
+		Noise:    &proto.LoadNoise{Stddev: 10, Seed: 43},
+	}, 0)
+	require.NotEqual(t, loadAt(noisy, 0), other)
+
+	// invalid
+	for _, config := range []*proto.MetricLoad{
+		{Load: 1},
+		{Timespan: durationpb.New(time.Minute), Interpolation: proto.MetricLoad_Sine, Period: durationpb.New(0)},
+		{Timespan: durationpb.New(time.Minute), Interpolation: proto.MetricLoad_Trace},
+		{Timespan: durationpb.New(time.Minute), Burst: &proto.LoadBurst{Duration: durationpb.New(time.Second)}},
+		{Timespan: durationpb.New(time.Minute), Noise: &proto.LoadNoise{Stddev: -1}},
+	} {
+		_, err := newSimLoad(config)
+		require.Error(t, err, config)
+	}
+}
+
+func TestSimLoadTrace(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "trace.csv")
+	err := os.WriteFile(csvPath, []byte("time,load\n0,10\n10,20\n30,0\n"), 0600)
+	require.NoError(t, err)
+	jsonPath := filepath.Join(dir, "trace.json")
+	err = os.WriteFile(jsonPath, []byte(`[
+		{"time": "2024-01-01T00:00:10Z", "load": 20},
+		{"time": "2024-01-01T00:00:00Z", "load": 10},
+		{"time": "2024-01-01T00:00:30Z", "load": 0}
+	]`), 0600)
+	require.NoError(t, err)
+
+	for _, path := range []string{csvPath, jsonPath} {
+		config := &proto.MetricLoad{
+			Interpolation: proto.MetricLoad_Trace,
+			Trace:         &proto.LoadTrace{File: path},
+		}
+		load, err := newSimLoad(config)
+		require.NoError(t, err, path)
+		require.Equal(t, 30*time.Second, load.timespan, path)
+		require.Equal(t, 10.0, load.loadAt(5*time.Second, 0), path)
+		require.Equal(t, 20.0, load.loadAt(20*time.Second, 0), path)
+		require.Equal(t, 0.0, load.loadAt(40*time.Second, 0), path)
+
+		config.Trace.Interpolation = proto.MetricLoad_Linear
+		load, err = newSimLoad(config)
+		require.NoError(t, err, path)
+		require.Equal(t, 15.0, load.loadAt(5*time.Second, 0), path)
+		require.Equal(t, 10.0, load.loadAt(20*time.Second, 0), path)
+	}
+
+	_, err = newSimLoad(&proto.MetricLoad{
+		Interpolation: proto.MetricLoad_Trace,
+		Trace:         &proto.LoadTrace{File: filepath.Join(dir, "missing.csv")},
+	})
+	require.Error(t, err)
+}
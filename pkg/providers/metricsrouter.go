@@ -0,0 +1,90 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	_ metricstypes.BatchMetricsClient            = &metricsRouter{}
+	_ metricstypes.AutoscalerScopedMetricsClient = &metricsRouter{}
+)
+
+// A metrics client that dispatches each metric to the configured metrics
+// client matching its config type.
+type metricsRouter struct{}
+
+// Returns a metrics client that routes each metric request to a metrics
+// client previously created by MetricsClient() based on metric config type.
+func NewMetricsRouter() metricstypes.MetricsClient {
+	return &metricsRouter{}
+}
+
+func (r *metricsRouter) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	client, err := MetricsClientByMetricConfig(config)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return client.GetMetric(ctx, metricName, autoscalerName, namespace, config)
+}
+
+// Groups requests by their metrics client and calls GetMetrics() once for each
+// client that implements BatchMetricsClient. Requests for other clients are
+// returned with ErrBatchNotSupported.
+func (r *metricsRouter) GetMetrics(ctx context.Context, requests []*metricstypes.MetricRequest) ([]*metricstypes.MetricResult, error) {
+	results := make([]*metricstypes.MetricResult, len(requests))
+	indexesByClient := make(map[metricstypes.BatchMetricsClient][]int)
+	for i, request := range requests {
+		client, err := MetricsClientByMetricConfig(request.Config)
+		if err != nil {
+			results[i] = &metricstypes.MetricResult{Err: err}
+			continue
+		}
+		batchClient, ok := client.(metricstypes.BatchMetricsClient)
+		if !ok {
+			results[i] = &metricstypes.MetricResult{Err: metricstypes.ErrBatchNotSupported}
+			continue
+		}
+		indexesByClient[batchClient] = append(indexesByClient[batchClient], i)
+	}
+
+	for client, indexes := range indexesByClient {
+		clientRequests := make([]*metricstypes.MetricRequest, len(indexes))
+		for i, index := range indexes {
+			clientRequests[i] = requests[index]
+		}
+		clientResults, err := client.GetMetrics(ctx, clientRequests)
+		if err == nil && len(clientResults) != len(clientRequests) {
+			err = fmt.Errorf("mismatched number of batch results: %d != %d", len(clientResults), len(clientRequests))
+		}
+		for i, index := range indexes {
+			if err != nil {
+				results[index] = &metricstypes.MetricResult{Err: err}
+			} else {
+				results[index] = clientResults[i]
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// Returns true if the metrics client of config is autoscaler scoped. Configs
+// without a metrics client are not.
+func (r *metricsRouter) AutoscalerScoped(config *anypb.Any) bool {
+	client, err := MetricsClientByMetricConfig(config)
+	if err != nil {
+		return false
+	}
+	scopedClient, ok := client.(metricstypes.AutoscalerScopedMetricsClient)
+
+	return ok && scopedClient.AutoscalerScoped(config)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metricsmocks "k9s-autoscaler/pkg/metrics/mocks"
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	configproto "k9s-autoscaler/pkg/providers/proto"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testMetricsClientFactory struct {
+	client metricstypes.MetricsClient
+}
+
+func (f *testMetricsClientFactory) MetricsClient(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	return f.client, nil
+}
+
+func TestMetricsRouter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client1 := metricsmocks.NewMockMetricsClient(mockCtrl)
+	client2 := metricsmocks.NewMockMetricsClient(mockCtrl)
+	RegisterMetricsClient(&wrapperspb.StringValue{}, &wrapperspb.Int32Value{}, &testMetricsClientFactory{client: client1})
+	RegisterMetricsClient(&wrapperspb.BytesValue{}, &wrapperspb.Int64Value{}, &testMetricsClientFactory{client: client2})
+	RegisterMetricsClient(&wrapperspb.BoolValue{}, &wrapperspb.UInt32Value{}, &testMetricsClientFactory{})
+
+	config1, err := anypb.New(&wrapperspb.StringValue{})
+	require.NoError(t, err)
+	_, err = MetricsClient(&configproto.ProviderConfig{Config: config1})
+	require.NoError(t, err)
+	config2, err := anypb.New(&wrapperspb.BytesValue{})
+	require.NoError(t, err)
+	_, err = MetricsClient(&configproto.ProviderConfig{Config: config2})
+	require.NoError(t, err)
+
+	metricConfig1, err := anypb.New(&wrapperspb.Int32Value{})
+	require.NoError(t, err)
+	metricConfig2, err := anypb.New(&wrapperspb.Int64Value{})
+	require.NoError(t, err)
+	client1.EXPECT().GetMetric(gomock.Any(), "metric1", "testas", "testns", metricConfig1).Return([]float64{1}, time.Time{}, nil)
+	client2.EXPECT().GetMetric(gomock.Any(), "metric2", "testas", "testns", metricConfig2).Return([]float64{2}, time.Time{}, nil)
+
+	router := NewMetricsRouter()
+	values, _, err := router.GetMetric(context.Background(), "metric1", "testas", "testns", metricConfig1)
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, values)
+	values, _, err = router.GetMetric(context.Background(), "metric2", "testas", "testns", metricConfig2)
+	require.NoError(t, err)
+	require.Equal(t, []float64{2}, values)
+
+	// registered but not configured
+	metricConfig3, err := anypb.New(&wrapperspb.UInt32Value{})
+	require.NoError(t, err)
+	_, _, err = router.GetMetric(context.Background(), "metric3", "testas", "testns", metricConfig3)
+	require.Error(t, err)
+
+	// not registered
+	unknownConfig, err := anypb.New(&wrapperspb.DoubleValue{})
+	require.NoError(t, err)
+	_, _, err = router.GetMetric(context.Background(), "metric4", "testas", "testns", unknownConfig)
+	require.Error(t, err)
+	_, _, err = router.GetMetric(context.Background(), "metric4", "testas", "testns", nil)
+	require.Error(t, err)
+}
+
+func TestMetricsRouterBatch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	batchClient := metricsmocks.NewMockBatchMetricsClient(mockCtrl)
+	client := metricsmocks.NewMockMetricsClient(mockCtrl)
+	RegisterMetricsClient(&wrapperspb.FloatValue{}, &wrapperspb.UInt64Value{}, &testMetricsClientFactory{client: batchClient})
+	RegisterMetricsClient(&durationpb.Duration{}, &timestamppb.Timestamp{}, &testMetricsClientFactory{client: client})
+	_, err := MetricsClient(&configproto.ProviderConfig{Config: mustAny(t, &wrapperspb.FloatValue{})})
+	require.NoError(t, err)
+	_, err = MetricsClient(&configproto.ProviderConfig{Config: mustAny(t, &durationpb.Duration{})})
+	require.NoError(t, err)
+
+	batchConfig := mustAny(t, &wrapperspb.UInt64Value{})
+	requests := []*metricstypes.MetricRequest{
+		{MetricName: "metric1", Config: batchConfig},
+		{MetricName: "metric2", Config: mustAny(t, &timestamppb.Timestamp{})},
+		{MetricName: "metric3", Config: batchConfig},
+		{MetricName: "metric4", Config: mustAny(t, &wrapperspb.DoubleValue{})},
+	}
+	batchClient.EXPECT().GetMetrics(gomock.Any(), []*metricstypes.MetricRequest{requests[0], requests[2]}).Return(
+		[]*metricstypes.MetricResult{{Values: []float64{1}}, {Values: []float64{3}}}, nil)
+
+	router := NewMetricsRouter().(metricstypes.BatchMetricsClient)
+	results, err := router.GetMetrics(context.Background(), requests)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	require.Equal(t, []float64{1}, results[0].Values)
+	require.ErrorIs(t, results[1].Err, metricstypes.ErrBatchNotSupported)
+	require.Equal(t, []float64{3}, results[2].Values)
+	require.Error(t, results[3].Err)
+
+	// batch failures are reported for each request
+	batchClient.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("test error"))
+	results, err = router.GetMetrics(context.Background(), requests[:1])
+	require.NoError(t, err)
+	require.Error(t, results[0].Err)
+}
+
+func mustAny(t *testing.T, message proto.Message) *anypb.Any {
+	a, err := anypb.New(message)
+	require.NoError(t, err)
+	return a
+}
@@ -4,6 +4,7 @@ package providers
 
 import (
 	"fmt"
+	"sync"
 
 	eventstypes "k9s-autoscaler/pkg/events/types"
 	metricstypes "k9s-autoscaler/pkg/metrics/types"
@@ -50,6 +51,13 @@ var (
 	scalingClientFactories = make(map[string]ScalingClientFactory)
 	eventsClientFactories  = make(map[string]EventsClientFactory)
 
+	// guards created clients, which are read by routers while factories
+	// create them.
+	clientsLock sync.RWMutex
+
+	metricsClients                      = make(map[string]metricstypes.MetricsClient)
+	metricsClientFactoryByMetricConfigs = make(map[string]string)
+
 	scalingClients                      = make(map[string]scalingtypes.ScalingClient)
 	scalingClientFactoryByTargetConfigs = make(map[string]string)
 )
@@ -77,25 +85,60 @@ func StorageClient(config *configproto.ProviderConfig) (*storage.Client, error)
 	}
 }
 
-// Registers a storage client provider adapter with name and factory.
-func RegisterMetricsClient(configMessage proto.Message, factory MetricsClientFactory) {
+// Registers a metrics client provider adapter with config and metricConfig and factory.
+func RegisterMetricsClient(configMessage proto.Message, metricConfigMessage proto.Message, factory MetricsClientFactory) {
 	name := typeNameForMessage(configMessage)
 	if _, ok := metricClientFactories[name]; ok {
 		panic(fmt.Sprintf("metrics client %s already registered", name))
 	}
-
+	metricName := typeNameForMessage(metricConfigMessage)
+	if _, ok := metricsClientFactoryByMetricConfigs[metricName]; ok {
+		panic(fmt.Sprintf("metrics client metric config %s already registered", metricName))
+	}
 	metricClientFactories[name] = factory
+	metricsClientFactoryByMetricConfigs[metricName] = name
+
+	klog.V(1).InfoS("registered metrics provider", "configType", name, "metricConfigType", metricName)
 }
 
-// Get a storage client with config. Name in config is used to lookup
-// previously registerd factory.
+// Get a metrics client with config. Name in config is used to lookup
+// previously registerd factory. Created client is retained such that it can
+// be looked up by metric configs using MetricsClientByMetricConfig().
 func MetricsClient(config *configproto.ProviderConfig) (metricstypes.MetricsClient, error) {
 	name := config.Config.TypeUrl
-	if f, ok := metricClientFactories[name]; !ok {
+	f, ok := metricClientFactories[name]
+	if !ok {
 		return nil, fmt.Errorf("no metrics client registered for %s", name)
-	} else {
-		return f.MetricsClient(config.Config)
 	}
+	client, err := f.MetricsClient(config.Config)
+	if err != nil {
+		return nil, err
+	}
+	clientsLock.Lock()
+	metricsClients[name] = client
+	clientsLock.Unlock()
+
+	return client, nil
+}
+
+// Gets a metrics client from a metric configuration.
+func MetricsClientByMetricConfig(config *anypb.Any) (metricstypes.MetricsClient, error) {
+	if config == nil {
+		return nil, fmt.Errorf("metric config must be specified")
+	}
+	name := config.TypeUrl
+	clientName, ok := metricsClientFactoryByMetricConfigs[name]
+	if !ok {
+		return nil, fmt.Errorf("no metrics client registered for %s", name)
+	}
+	clientsLock.RLock()
+	client, ok := metricsClients[clientName]
+	clientsLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("client %s for metric %s not configured", clientName, name)
+	}
+
+	return client, nil
 }
 
 // Registers a storage client provider adapter with config and targetConfig and factory.
@@ -115,24 +158,38 @@ func RegisterScalingClient(configMessage proto.Message, targetConfigMessage prot
 }
 
 // Gets a scaling client with config. Name in config is used to lookup
-// previously registerd factory.
+// previously registerd factory. Created client is retained such that it can
+// be looked up by target configs using ScalingClientByTargetConfig().
 func ScalingClient(config *configproto.ProviderConfig) (scalingtypes.ScalingClient, error) {
 	name := config.Config.TypeUrl
-	if f, ok := scalingClientFactories[name]; !ok {
+	f, ok := scalingClientFactories[name]
+	if !ok {
 		return nil, fmt.Errorf("no scaling client registered for %s", name)
-	} else {
-		return f.ScalingClient(config.Config)
 	}
+	client, err := f.ScalingClient(config.Config)
+	if err != nil {
+		return nil, err
+	}
+	clientsLock.Lock()
+	scalingClients[name] = client
+	clientsLock.Unlock()
+
+	return client, nil
 }
 
 // Gets a scaling client from a scaling target configuration.
 func ScalingClientByTargetConfig(config *anypb.Any) (scalingtypes.ScalingClient, error) {
+	if config == nil {
+		return nil, fmt.Errorf("scaling target config must be specified")
+	}
 	name := config.TypeUrl
 	clientName, ok := scalingClientFactoryByTargetConfigs[name]
 	if !ok {
 		return nil, fmt.Errorf("no scaling client registered for %s", name)
 	}
+	clientsLock.RLock()
 	client, ok := scalingClients[clientName]
+	clientsLock.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("client %s for target %s not configured", clientName, name)
 	}
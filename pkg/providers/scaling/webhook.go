@@ -0,0 +1,258 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package scaling
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/jsonpath"
+	"k9s-autoscaler/pkg/providers/scaling/proto"
+	scalingtypes "k9s-autoscaler/pkg/scale/types"
+
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// Generic http webhook scaling provider adapter. Scale is read by calling an
+// http endpoint and extracting it from its JSON response using JSONPath, and
+// set by calling another endpoint with the desired scale.
+// see: pkg/providers/scaling/proto/webhook.proto
+type webhook struct {
+	client *http.Client
+}
+
+type webhookFactory struct{}
+
+func init() {
+	providers.RegisterScalingClient(&proto.WebhookConfig{}, &proto.WebhookTargetConfig{}, &webhookFactory{})
+}
+
+func (f *webhookFactory) ScalingClient(config *anypb.Any) (scalingtypes.ScalingClient, error) {
+	webhookConfig := proto.WebhookConfig{}
+	if err := anypb.UnmarshalTo(config, &webhookConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &webhook{
+		client: &http.Client{},
+	}, nil
+}
+
+func (w *webhook) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+	targetConfig, err := w.getScaleTargetConfig(scaleTarget)
+	if err != nil {
+		return err
+	}
+	if targetConfig.Set == nil || len(targetConfig.Set.Url) == 0 {
+		return fmt.Errorf("set url is required")
+	}
+	if targetConfig.Set.Method == proto.WebhookRequest_GET {
+		return fmt.Errorf("set method must be POST or PUT")
+	}
+
+	desired := strconv.Itoa(int(target.Desired))
+	resp, err := w.do(ctx, targetConfig.Set, http.MethodPut, name, namespace, desired)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	klog.V(4).InfoS("webhook scale set", "autoscaler", name, "namespace", namespace, "desired", target.Desired)
+
+	return nil
+}
+
+func (w *webhook) GetScale(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
+	targetConfig, err := w.getScaleTargetConfig(scaleTarget)
+	if err != nil {
+		return nil, err
+	}
+	if targetConfig.Get == nil || len(targetConfig.Get.Url) == 0 {
+		return nil, fmt.Errorf("get url is required")
+	}
+	if len(targetConfig.CurrentJsonPath) == 0 {
+		return nil, fmt.Errorf("current json path is required")
+	}
+
+	resp, err := w.do(ctx, targetConfig.Get, http.MethodGet, name, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var data interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	current, err := jsonPathScale("current", targetConfig.CurrentJsonPath, data)
+	if err != nil {
+		return nil, err
+	}
+	desired := current
+	if len(targetConfig.DesiredJsonPath) > 0 {
+		desired, err = jsonPathScale("desired", targetConfig.DesiredJsonPath, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	klog.V(4).InfoS("webhook scale", "autoscaler", name, "namespace", namespace, "current", current, "desired", desired)
+
+	return &prototypes.Scale{
+		Spec: &prototypes.ScaleSpec{
+			Desired: desired,
+		},
+		Status: &prototypes.ScaleStatus{
+			Current: current,
+		},
+	}, nil
+}
+
+// Sends request with placeholders replaced. defaultMethod is used if request
+// method is not set.
+func (w *webhook) do(ctx context.Context, request *proto.WebhookRequest, defaultMethod, name, namespace, desired string) (*http.Response, error) {
+	timeout := defaultWebhookTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+
+	placeholders := strings.NewReplacer(
+		"{autoscalerName}", name,
+		"{namespace}", namespace,
+		"{desired}", desired)
+	urlPlaceholders := strings.NewReplacer(
+		"{autoscalerName}", url.PathEscape(name),
+		"{namespace}", url.PathEscape(namespace),
+		"{desired}", desired)
+
+	method := defaultMethod
+	switch request.Method {
+	case proto.WebhookRequest_GET:
+		method = http.MethodGet
+	case proto.WebhookRequest_POST:
+		method = http.MethodPost
+	case proto.WebhookRequest_PUT:
+		method = http.MethodPut
+	}
+	var body io.Reader
+	if len(request.Body) > 0 {
+		bodyPlaceholders := placeholders
+		if isJSONRequest(request) {
+			bodyPlaceholders = strings.NewReplacer(
+				"{autoscalerName}", jsonEscape(name),
+				"{namespace}", jsonEscape(namespace),
+				"{desired}", desired)
+		}
+		body = bytes.NewBufferString(bodyPlaceholders.Replace(request.Body))
+	}
+
+	// request context is cancelled once the response body is closed.
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	req, err := http.NewRequestWithContext(ctx, method, urlPlaceholders.Replace(request.Url), body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range request.Headers {
+		req.Header.Set(name, placeholders.Replace(value))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+func (w *webhook) getScaleTargetConfig(scaleTarget *prototypes.AutoscalerTarget) (*proto.WebhookTargetConfig, error) {
+	config := proto.WebhookTargetConfig{}
+	if err := anypb.UnmarshalTo(scaleTarget.Config, &config, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Extracts a single whole scale value matched by path in data.
+func jsonPathScale(name, path string, data interface{}) (int32, error) {
+	parser, err := jsonpath.Parse(name, path)
+	if err != nil {
+		return 0, err
+	}
+	values, err := jsonpath.Values(parser, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s scale: %v", name, err)
+	}
+	if len(values) != 1 {
+		return 0, fmt.Errorf("json path %s matched %d values, expected 1", path, len(values))
+	}
+	value := values[0]
+	if value != math.Trunc(value) || value < 0 || value > math.MaxInt32 {
+		return 0, fmt.Errorf("%s scale %v is not a valid scale", name, value)
+	}
+
+	return int32(value), nil
+}
+
+// Returns true if request body is JSON, which is the default unless a
+// different Content-Type header is set.
+func isJSONRequest(request *proto.WebhookRequest) bool {
+	for name, value := range request.Headers {
+		if strings.EqualFold(name, "Content-Type") {
+			return strings.Contains(strings.ToLower(value), "json")
+		}
+	}
+
+	return true
+}
+
+// Escapes s to be used within a JSON string.
+func jsonEscape(s string) string {
+	b, _ := json.Marshal(s)
+
+	return string(b[1 : len(b)-1])
+}
+
+// Cancels a request context once its response body is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	defer c.cancel()
+
+	return c.ReadCloser.Close()
+}
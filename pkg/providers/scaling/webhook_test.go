@@ -0,0 +1,167 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package scaling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/providers/scaling/proto"
+
+	"github.com/stretchr/testify/require"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func mustTarget(t *testing.T, config *proto.WebhookTargetConfig) *prototypes.AutoscalerTarget {
+	a, err := anypb.New(config)
+	require.NoError(t, err)
+	return &prototypes.AutoscalerTarget{Config: a}
+}
+
+func TestWebhook(t *testing.T) {
+	lock := sync.Mutex{}
+	capacity := 3
+	// handler failures are asserted on the test goroutine.
+	failures := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.Header.Get("Authorization") != "token-testns" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/capacity/testns/testas" {
+			failures = append(failures, fmt.Sprintf("unexpected path %s", r.URL.Path))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"capacity": {"current": 3, "target": "5", "fraction": 1.5}}`))
+		case http.MethodPut, http.MethodPost:
+			body := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				failures = append(failures, fmt.Sprintf("failed to decode body: %v", err))
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if body["autoscaler"] != "testas" || body["capacity"] != 7.0 {
+				failures = append(failures, fmt.Sprintf("unexpected body %v", body))
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			capacity = 7
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+	defer func() {
+		lock.Lock()
+		defer lock.Unlock()
+		require.Empty(t, failures)
+	}()
+
+	a, err := anypb.New(&proto.WebhookConfig{})
+	require.NoError(t, err)
+	client, err := (&webhookFactory{}).ScalingClient(a)
+	require.NoError(t, err)
+
+	capacityURL := server.URL + "/capacity/{namespace}/{autoscalerName}"
+	headers := map[string]string{"Authorization": "token-{namespace}"}
+	config := &proto.WebhookTargetConfig{
+		Get:             &proto.WebhookRequest{Url: capacityURL, Headers: headers},
+		CurrentJsonPath: "{.capacity.current}",
+		Set: &proto.WebhookRequest{
+			Url:     capacityURL,
+			Headers: headers,
+			Body:    `{"autoscaler": "{autoscalerName}", "capacity": {desired}}`,
+		},
+	}
+
+	scale, err := client.GetScale(context.Background(), "testas", "testns", mustTarget(t, config))
+	require.NoError(t, err)
+	require.Equal(t, int32(3), scale.Spec.Desired)
+	require.Equal(t, int32(3), scale.Status.Current)
+
+	config.DesiredJsonPath = ".capacity.target"
+	scale, err = client.GetScale(context.Background(), "testas", "testns", mustTarget(t, config))
+	require.NoError(t, err)
+	require.Equal(t, int32(5), scale.Spec.Desired)
+	require.Equal(t, int32(3), scale.Status.Current)
+
+	for _, method := range []proto.WebhookRequest_Method{proto.WebhookRequest_Default, proto.WebhookRequest_POST} {
+		config.Set.Method = method
+		err = client.SetScaleTarget(context.Background(), "testas", "testns", mustTarget(t, config), &prototypes.ScaleSpec{Desired: 7})
+		require.NoError(t, err)
+		lock.Lock()
+		require.Equal(t, 7, capacity)
+		capacity = 3
+		lock.Unlock()
+	}
+
+	// errors
+	for _, modify := range []func(config *proto.WebhookTargetConfig){
+		func(config *proto.WebhookTargetConfig) { config.CurrentJsonPath = "" },
+		func(config *proto.WebhookTargetConfig) { config.CurrentJsonPath = "{.capacity.missing}" },
+		func(config *proto.WebhookTargetConfig) { config.CurrentJsonPath = "{.capacity.fraction}" },
+		func(config *proto.WebhookTargetConfig) { config.CurrentJsonPath = "{.capacity.*}" },
+		func(config *proto.WebhookTargetConfig) { config.DesiredJsonPath = "{.capacity" },
+		func(config *proto.WebhookTargetConfig) { config.Get = nil },
+		func(config *proto.WebhookTargetConfig) { config.Get.Headers = nil },
+	} {
+		errConfig := protob.Clone(config).(*proto.WebhookTargetConfig)
+		modify(errConfig)
+		_, err = client.GetScale(context.Background(), "testas", "testns", mustTarget(t, errConfig))
+		require.Error(t, err, errConfig)
+	}
+	for _, modify := range []func(config *proto.WebhookTargetConfig){
+		func(config *proto.WebhookTargetConfig) { config.Set = nil },
+		func(config *proto.WebhookTargetConfig) { config.Set.Method = proto.WebhookRequest_GET },
+		func(config *proto.WebhookTargetConfig) { config.Set.Headers = nil },
+	} {
+		errConfig := protob.Clone(config).(*proto.WebhookTargetConfig)
+		modify(errConfig)
+		err = client.SetScaleTarget(context.Background(), "testas", "testns", mustTarget(t, errConfig), &prototypes.ScaleSpec{Desired: 7})
+		require.Error(t, err, errConfig)
+	}
+}
+
+func TestWebhookBodyEscape(t *testing.T) {
+	bodies := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &webhook{client: &http.Client{}}
+	request := &proto.WebhookRequest{
+		Url:  server.URL,
+		Body: `{"autoscaler": "{autoscalerName}", "namespace": "{namespace}"}`,
+	}
+	name := `test"as\`
+
+	// values are escaped in JSON bodies
+	resp, err := client.do(context.Background(), request, http.MethodPut, name, "testns", "")
+	require.NoError(t, err)
+	resp.Body.Close()
+	body := map[string]string{}
+	require.NoError(t, json.Unmarshal([]byte(<-bodies), &body))
+	require.Equal(t, map[string]string{"autoscaler": name, "namespace": "testns"}, body)
+
+	// and kept as is otherwise
+	request.Headers = map[string]string{"Content-Type": "text/plain"}
+	resp, err = client.do(context.Background(), request, http.MethodPut, name, "testns", "")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, `{"autoscaler": "test"as\", "namespace": "testns"}`, <-bodies)
+}
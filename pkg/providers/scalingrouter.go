@@ -0,0 +1,51 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package providers
+
+import (
+	"context"
+	"fmt"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	scalingtypes "k9s-autoscaler/pkg/scale/types"
+)
+
+var (
+	_ scalingtypes.ScalingClient = &scalingRouter{}
+)
+
+// A scaling client that dispatches each scaling call to the configured
+// scaling client matching the autoscaler target config type.
+type scalingRouter struct{}
+
+// Returns a scaling client that routes each call to a scaling client
+// previously created by ScalingClient() based on target config type.
+func NewScalingRouter() scalingtypes.ScalingClient {
+	return &scalingRouter{}
+}
+
+func (r *scalingRouter) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+	client, err := scalingClientForTarget(scaleTarget)
+	if err != nil {
+		return err
+	}
+
+	return client.SetScaleTarget(ctx, name, namespace, scaleTarget, target)
+}
+
+func (r *scalingRouter) GetScale(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
+	client, err := scalingClientForTarget(scaleTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetScale(ctx, name, namespace, scaleTarget)
+}
+
+func scalingClientForTarget(scaleTarget *prototypes.AutoscalerTarget) (scalingtypes.ScalingClient, error) {
+	if scaleTarget == nil {
+		return nil, fmt.Errorf("scaling target must be specified")
+	}
+
+	return ScalingClientByTargetConfig(scaleTarget.Config)
+}
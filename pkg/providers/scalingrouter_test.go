@@ -0,0 +1,69 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package providers
+
+import (
+	"context"
+	"testing"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	configproto "k9s-autoscaler/pkg/providers/proto"
+	scalingmocks "k9s-autoscaler/pkg/scale/mocks"
+	scalingtypes "k9s-autoscaler/pkg/scale/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testScalingClientFactory struct {
+	client scalingtypes.ScalingClient
+}
+
+func (f *testScalingClientFactory) ScalingClient(config *anypb.Any) (scalingtypes.ScalingClient, error) {
+	return f.client, nil
+}
+
+func TestScalingRouter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client1 := scalingmocks.NewMockScalingClient(mockCtrl)
+	client2 := scalingmocks.NewMockScalingClient(mockCtrl)
+	RegisterScalingClient(&wrapperspb.StringValue{}, &wrapperspb.Int32Value{}, &testScalingClientFactory{client: client1})
+	RegisterScalingClient(&wrapperspb.BytesValue{}, &wrapperspb.Int64Value{}, &testScalingClientFactory{client: client2})
+	RegisterScalingClient(&wrapperspb.BoolValue{}, &wrapperspb.UInt32Value{}, &testScalingClientFactory{})
+
+	for _, message := range []*anypb.Any{mustAny(t, &wrapperspb.StringValue{}), mustAny(t, &wrapperspb.BytesValue{})} {
+		_, err := ScalingClient(&configproto.ProviderConfig{Config: message})
+		require.NoError(t, err)
+	}
+
+	target1 := &prototypes.AutoscalerTarget{Config: mustAny(t, &wrapperspb.Int32Value{})}
+	target2 := &prototypes.AutoscalerTarget{Config: mustAny(t, &wrapperspb.Int64Value{})}
+	client1.EXPECT().GetScale(gomock.Any(), "testas1", "testns", target1).Return(&prototypes.Scale{Spec: &prototypes.ScaleSpec{Desired: 1}}, nil)
+	client2.EXPECT().GetScale(gomock.Any(), "testas2", "testns", target2).Return(&prototypes.Scale{Spec: &prototypes.ScaleSpec{Desired: 2}}, nil)
+	client2.EXPECT().SetScaleTarget(gomock.Any(), "testas2", "testns", target2, &prototypes.ScaleSpec{Desired: 3}).Return(nil)
+
+	router := NewScalingRouter()
+	scale, err := router.GetScale(context.Background(), "testas1", "testns", target1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, scale.Spec.Desired)
+	scale, err = router.GetScale(context.Background(), "testas2", "testns", target2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, scale.Spec.Desired)
+	err = router.SetScaleTarget(context.Background(), "testas2", "testns", target2, &prototypes.ScaleSpec{Desired: 3})
+	require.NoError(t, err)
+
+	// registered but not configured
+	_, err = router.GetScale(context.Background(), "testas3", "testns", &prototypes.AutoscalerTarget{Config: mustAny(t, &wrapperspb.UInt32Value{})})
+	require.Error(t, err)
+	// not registered
+	err = router.SetScaleTarget(context.Background(), "testas4", "testns", &prototypes.AutoscalerTarget{Config: mustAny(t, &wrapperspb.DoubleValue{})}, &prototypes.ScaleSpec{})
+	require.Error(t, err)
+	_, err = router.GetScale(context.Background(), "testas4", "testns", nil)
+	require.Error(t, err)
+	_, err = router.GetScale(context.Background(), "testas4", "testns", &prototypes.AutoscalerTarget{})
+	require.Error(t, err)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/storage/proto"
+	providertypes "k9s-autoscaler/pkg/providers/types"
+	"k9s-autoscaler/pkg/storage"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/protobuf/encoding/protojson"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+type fileStorage struct{}
+
+// Watches a yaml file for changes and reconciles its autoscalers into storage.
+type fileWatcher struct {
+	sync.Mutex
+
+	path        string
+	reconciler  providertypes.Reconciler
+	watcher     *fsnotify.Watcher
+	lastContent []byte
+	stopChan    chan struct{}
+	doneChan    chan struct{}
+}
+
+func init() {
+	providers.RegisterStorageClient(&proto.FileStorageConfig{}, &fileStorage{})
+}
+
+// File storage provider defines a provider that reads autoscaler configurations
+// from an external yaml file in InlineStorageConfig format. The file is watched
+// for changes and autoscalers are added, updated or deleted accordingly.
+// see: pkg/providers/storage/proto/file.proto
+func (f *fileStorage) StorageClient(config *anypb.Any) (*storage.Client, error) {
+	fileConfig := proto.FileStorageConfig{}
+	if err := anypb.UnmarshalTo(config, &fileConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+	if len(fileConfig.Path) == 0 {
+		return nil, fmt.Errorf("path must be specified")
+	}
+
+	client, err := storage.NewClient(f)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newFileWatcher(fileConfig.Path, providers.NewReconciler(client))
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	client.OnClose(w.stop)
+
+	return client, nil
+}
+
+func (f *fileStorage) AutoscalerStatusUpdated(autoscaler *prototypes.Autoscaler) {
+}
+
+// Creates a new watcher for path that loads its content using reconciler, then
+// starts watching for changes. Initial load must succeed.
+func newFileWatcher(path string, reconciler providertypes.Reconciler) (*fileWatcher, error) {
+	w := &fileWatcher{
+		path:       path,
+		reconciler: reconciler,
+		stopChan:   make(chan struct{}),
+		doneChan:   make(chan struct{}),
+	}
+	if err := w.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %v", err)
+	}
+	// watch the parent directory so that atomic replacements, such as editor
+	// renames or kubernetes configmap symlink swaps, are also detected.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch %s: %v", path, err)
+	}
+	w.watcher = watcher
+
+	go w.run()
+
+	return w, nil
+}
+
+// Stops watching for changes.
+func (w *fileWatcher) stop() {
+	close(w.stopChan)
+	<-w.doneChan
+}
+
+func (w *fileWatcher) run() {
+	defer close(w.doneChan)
+	defer w.watcher.Close()
+
+	for {
+		select {
+		case <-w.stopChan:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if !w.isWatchedEvent(event) {
+				continue
+			}
+			klog.V(4).InfoS("file storage watch event", "path", w.path, "event", event)
+			if err := w.reload(); err != nil {
+				klog.InfoS("file storage failed to reload autoscalers", "path", w.path, "error", err)
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			klog.InfoS("file storage watch error", "path", w.path, "error", err)
+		}
+	}
+}
+
+// Returns true if event is for the watched file. Kubernetes configmap mounts
+// replace files by swapping the ..data symlink, so its events are also
+// included.
+func (w *fileWatcher) isWatchedEvent(event fsnotify.Event) bool {
+	name := filepath.Base(event.Name)
+
+	return name == filepath.Base(w.path) || name == "..data"
+}
+
+// Reads and reconciles file content if it has changed since last successful
+// reload.
+func (w *fileWatcher) reload() error {
+	w.Lock()
+	defer w.Unlock()
+
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	if w.lastContent != nil && bytes.Equal(content, w.lastContent) {
+		return nil
+	}
+
+	jsonBytes, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return err
+	}
+	fileConfig := proto.InlineStorageConfig{}
+	if err := protojson.Unmarshal(jsonBytes, &fileConfig); err != nil {
+		return fmt.Errorf("failed to process autoscalers: %v", err)
+	}
+
+	klog.V(0).InfoS("reconciling autoscalers from file", "path", w.path, "count", len(fileConfig.Autoscalers))
+	if err := w.reconciler.Reconcile(fileConfig.Autoscalers); err != nil {
+		return err
+	}
+	w.lastContent = content
+
+	return nil
+}
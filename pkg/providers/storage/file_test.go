@@ -0,0 +1,123 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/storage/proto"
+	"k9s-autoscaler/pkg/storage"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestFileStorageReload(t *testing.T) {
+	initialYAML := `
+autoscalers:
+- name: testauto1
+  namespace: testnamespace
+  spec:
+    min: 1
+    max: 10
+    metrics:
+    - name: testmetric
+      target: 70
+`
+	updatedYAML := `
+autoscalers:
+- name: testauto1
+  namespace: testnamespace
+  spec:
+    min: 1
+    max: 20
+    metrics:
+    - name: testmetric
+      target: 70
+- name: testauto2
+  namespace: testnamespace
+  spec:
+    min: 1
+    max: 5
+    metrics:
+    - name: testmetric
+      target: 70
+`
+	path := filepath.Join(t.TempDir(), "autoscalers.yaml")
+	err := os.WriteFile(path, []byte(initialYAML), 0644)
+	require.NoError(t, err)
+
+	client, err := storage.NewClient(&fileStorage{})
+	require.NoError(t, err)
+	defer client.Close()
+	w, err := newFileWatcher(path, providers.NewReconciler(client))
+	require.NoError(t, err)
+	defer w.stop()
+
+	list, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.EqualValues(t, 10, list[0].Spec.Max)
+
+	// update and add
+	err = os.WriteFile(path, []byte(updatedYAML), 0644)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		list, err := client.List()
+		return err == nil && len(list) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	autoscaler, err := client.Get("testauto1", "testnamespace")
+	require.NoError(t, err)
+	require.EqualValues(t, 20, autoscaler.Spec.Max)
+
+	// invalid content keeps existing autoscalers
+	err = os.WriteFile(path, []byte("autoscalers: [invalid"), 0644)
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	list, err = client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	// delete
+	err = os.WriteFile(path, []byte(initialYAML), 0644)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		list, err := client.List()
+		return err == nil && len(list) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestFileStorageClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "autoscalers.yaml")
+	err := os.WriteFile(path, []byte("autoscalers: []"), 0644)
+	require.NoError(t, err)
+
+	config, err := anypb.New(&proto.FileStorageConfig{Path: path})
+	require.NoError(t, err)
+	client, err := (&fileStorage{}).StorageClient(config)
+	require.NoError(t, err)
+	client.Close()
+
+	// client is closed if initial load fails
+	err = os.WriteFile(path, []byte("autoscalers: [invalid"), 0644)
+	require.NoError(t, err)
+	_, err = (&fileStorage{}).StorageClient(config)
+	require.Error(t, err)
+	client, err = storage.NewClient(&fileStorage{})
+	require.NoError(t, err)
+	client.Close()
+}
+
+func TestFileStorageWatchedEvent(t *testing.T) {
+	w := &fileWatcher{path: "/config/autoscalers.yaml"}
+
+	require.True(t, w.isWatchedEvent(fsnotify.Event{Name: "/config/autoscalers.yaml"}))
+	require.True(t, w.isWatchedEvent(fsnotify.Event{Name: "/config/..data"}))
+	require.False(t, w.isWatchedEvent(fsnotify.Event{Name: "/config/other.yaml"}))
+	require.False(t, w.isWatchedEvent(fsnotify.Event{Name: "/config/.autoscalers.yaml.swp"}))
+}
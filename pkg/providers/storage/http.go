@@ -0,0 +1,202 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	api "k9s-autoscaler/pkg/http/openapi"
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/storage/proto"
+	providertypes "k9s-autoscaler/pkg/providers/types"
+	"k9s-autoscaler/pkg/storage"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protob "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultHTTPPollInterval = 30 * time.Second
+)
+
+type httpStorage struct{}
+
+// Polls a remote server for autoscalers and reconciles them into storage.
+type httpPoller struct {
+	client     *api.Client
+	reconciler providertypes.Reconciler
+	interval   time.Duration
+	etag       string
+	ctx        context.Context
+	cancel     context.CancelFunc
+	doneChan   chan struct{}
+}
+
+func init() {
+	providers.RegisterStorageClient(&proto.HTTPStorageConfig{}, &httpStorage{})
+}
+
+// HTTP storage provider defines a provider that periodically polls a remote
+// server implementing GET /autoscalers from pkg/http/openapi/api.yaml. ETag is
+// used to detect changes, and changed autoscalers are reconciled into storage.
+// see: pkg/providers/storage/proto/http.proto
+func (h *httpStorage) StorageClient(config *anypb.Any) (*storage.Client, error) {
+	httpConfig := proto.HTTPStorageConfig{}
+	if err := anypb.UnmarshalTo(config, &httpConfig, protob.UnmarshalOptions{}); err != nil {
+		return nil, err
+	}
+
+	client, err := storage.NewClient(h)
+	if err != nil {
+		return nil, err
+	}
+	poller, err := newHTTPPoller(&httpConfig, providers.NewReconciler(client))
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	if err := poller.poll(context.Background()); err != nil {
+		client.Close()
+		return nil, err
+	}
+	go poller.run()
+	client.OnClose(poller.stop)
+
+	return client, nil
+}
+
+func (h *httpStorage) AutoscalerStatusUpdated(autoscaler *prototypes.Autoscaler) {
+}
+
+// Creates a new poller from config that uses reconciler to reflect changes.
+// Poller must be started by calling run().
+func newHTTPPoller(config *proto.HTTPStorageConfig, reconciler providertypes.Reconciler) (*httpPoller, error) {
+	if len(config.Url) == 0 {
+		return nil, fmt.Errorf("url must be specified")
+	}
+	interval := defaultHTTPPollInterval
+	if config.PollInterval != nil {
+		interval = config.PollInterval.AsDuration()
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("poll interval must be > 0")
+	}
+
+	headers := config.Headers
+	client, err := api.NewClient(
+		config.Url,
+		api.WithHTTPClient(&http.Client{Timeout: interval}),
+		api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			for name, value := range headers {
+				req.Header.Set(name, value)
+			}
+			return nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &httpPoller{
+		client:     client,
+		reconciler: reconciler,
+		interval:   interval,
+		ctx:        ctx,
+		cancel:     cancel,
+		doneChan:   make(chan struct{}),
+	}, nil
+}
+
+func (p *httpPoller) run() {
+	defer close(p.doneChan)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.poll(p.ctx); err != nil {
+				klog.InfoS("http storage failed to poll autoscalers", "error", err)
+			}
+		}
+	}
+}
+
+// Stops polling, cancelling any in-flight poll.
+func (p *httpPoller) stop() {
+	p.cancel()
+	<-p.doneChan
+}
+
+// Fetches autoscalers and reconciles them if they have changed since last
+// successful poll.
+func (p *httpPoller) poll(ctx context.Context) error {
+	resp, err := p.client.GetAutoscalers(ctx, func(ctx context.Context, req *http.Request) error {
+		if len(p.etag) > 0 {
+			req.Header.Set("If-None-Match", p.etag)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		klog.V(4).InfoS("http storage autoscalers not modified", "etag", p.etag)
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	autoscalers, err := unmarshalAutoscalers(body)
+	if err != nil {
+		return fmt.Errorf("failed to process autoscalers: %v", err)
+	}
+
+	etag := resp.Header.Get("ETag")
+	klog.V(0).InfoS("reconciling autoscalers from http", "etag", etag, "count", len(autoscalers))
+	if err := p.reconciler.Reconcile(autoscalers); err != nil {
+		return err
+	}
+	p.etag = etag
+
+	return nil
+}
+
+// Decodes a json array of autoscalers. Each autoscaler is decoded using protojson
+// to support typed provider configs.
+func unmarshalAutoscalers(body []byte) ([]*prototypes.Autoscaler, error) {
+	var rawAutoscalers []json.RawMessage
+	if err := json.Unmarshal(body, &rawAutoscalers); err != nil {
+		return nil, err
+	}
+
+	autoscalers := make([]*prototypes.Autoscaler, len(rawAutoscalers))
+	for i, raw := range rawAutoscalers {
+		autoscaler := prototypes.Autoscaler{}
+		if err := protojson.Unmarshal(raw, &autoscaler); err != nil {
+			return nil, err
+		}
+		autoscalers[i] = &autoscaler
+	}
+
+	return autoscalers, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"k9s-autoscaler/pkg/providers"
+	"k9s-autoscaler/pkg/providers/storage/proto"
+	"k9s-autoscaler/pkg/storage"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHTTPStoragePoll(t *testing.T) {
+	initialJSON := `[
+	{"name": "testauto1", "namespace": "testnamespace", "spec": {"min": 1, "max": 10, "metrics": [{"name": "testmetric", "target": 70}]}}
+]`
+	updatedJSON := `[
+	{"name": "testauto1", "namespace": "testnamespace", "spec": {"min": 1, "max": 20, "metrics": [{"name": "testmetric", "target": 70}]}},
+	{"name": "testauto2", "namespace": "testnamespace", "spec": {"min": 1, "max": 5, "metrics": [{"name": "testmetric", "target": 70}]}}
+]`
+
+	var lock sync.Mutex
+	body := initialJSON
+	etag := `"1"`
+	notModifiedCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		require.Equal(t, "/autoscalers", r.URL.Path)
+		require.Equal(t, "testtoken", r.Header.Get("Authorization"))
+		if r.Header.Get("If-None-Match") == etag {
+			notModifiedCount++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := storage.NewClient(&httpStorage{})
+	require.NoError(t, err)
+	defer client.Close()
+	poller, err := newHTTPPoller(
+		&proto.HTTPStorageConfig{
+			Url:     server.URL,
+			Headers: map[string]string{"Authorization": "testtoken"},
+		},
+		providers.NewReconciler(client))
+	require.NoError(t, err)
+
+	err = poller.poll(context.Background())
+	require.NoError(t, err)
+	list, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.EqualValues(t, 10, list[0].Spec.Max)
+
+	// not modified
+	err = poller.poll(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, notModifiedCount)
+
+	// update and add
+	lock.Lock()
+	body = updatedJSON
+	etag = `"2"`
+	lock.Unlock()
+	err = poller.poll(context.Background())
+	require.NoError(t, err)
+	list, err = client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	autoscaler, err := client.Get("testauto1", "testnamespace")
+	require.NoError(t, err)
+	require.EqualValues(t, 20, autoscaler.Spec.Max)
+
+	// invalid content keeps existing autoscalers
+	lock.Lock()
+	body = `[{"invalid`
+	etag = `"3"`
+	lock.Unlock()
+	err = poller.poll(context.Background())
+	require.Error(t, err)
+	list, err = client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+func TestHTTPStorageClose(t *testing.T) {
+	var lock sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		polls++
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	getPolls := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return polls
+	}
+
+	config, err := anypb.New(&proto.HTTPStorageConfig{
+		Url:          server.URL,
+		PollInterval: durationpb.New(10 * time.Millisecond),
+	})
+	require.NoError(t, err)
+	client, err := (&httpStorage{}).StorageClient(config)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return getPolls() > 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// a cancelled in-flight poll may still reach the server
+	client.Close()
+	time.Sleep(50 * time.Millisecond)
+	closedPolls := getPolls()
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, closedPolls, getPolls())
+
+	// client is closed if initial poll fails
+	server.Close()
+	_, err = (&httpStorage{}).StorageClient(config)
+	require.Error(t, err)
+	client, err = storage.NewClient(&httpStorage{})
+	require.NoError(t, err)
+	client.Close()
+}
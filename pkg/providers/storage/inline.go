@@ -38,6 +38,7 @@ func (f *inlineStorage) StorageClient(config *anypb.Any) (*storage.Client, error
 	}
 	for _, autoscalerConfig := range inlineConfig.Autoscalers {
 		if err := client.Add(autoscalerConfig); err != nil {
+			client.Close()
 			return nil, err
 		}
 	}
@@ -0,0 +1,113 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage/types"
+
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// Number of events buffered per autoscaler watch before it is considered
+	// too slow and is closed.
+	AutoscalerWatchBufferSize = 256
+)
+
+var (
+	_ types.AutoscalerWatch   = &autoscalerEventWatch{}
+	_ types.AutoscalerWatcher = &Client{}
+)
+
+// A watch that delivers autoscaler events for external consumers. Unlike
+// autoscalerWatch, events are buffered and never block storage. If buffer is
+// full, the watch is closed. All fields are protected by client lock.
+type autoscalerEventWatch struct {
+	client    *Client
+	namespace string
+	c         chan types.AutoscalerEvent
+	stopped   bool
+}
+
+// Watch changes to autoscalers in namespace, or all namespaces if empty.
+// Returns a snapshot of current autoscalers and a watch that delivers all
+// changes after the snapshot, including status updates.
+// Implements AutoscalerWatcher.
+func (c *Client) WatchAutoscalers(namespace string) ([]*prototypes.Autoscaler, types.AutoscalerWatch, error) {
+	klog.V(1).InfoS("creating new autoscaler watch", "namespace", namespace)
+
+	c.Lock()
+	defer c.Unlock()
+
+	snapshot := make([]*prototypes.Autoscaler, 0)
+	for entryNamespace, autoscalersByName := range c.autoscalerByNamespaceName {
+		if namespace == v1.NamespaceAll || namespace == entryNamespace {
+			for _, entry := range autoscalersByName {
+				snapshot = append(snapshot, proto.Clone(entry.autoscaler).(*prototypes.Autoscaler))
+			}
+		}
+	}
+
+	w := &autoscalerEventWatch{
+		client:    c,
+		namespace: namespace,
+		c:         make(chan types.AutoscalerEvent, AutoscalerWatchBufferSize),
+	}
+	if _, ok := c.eventWatchesByNamespace[namespace]; !ok {
+		c.eventWatchesByNamespace[namespace] = make(map[*autoscalerEventWatch]bool)
+	}
+	c.eventWatchesByNamespace[namespace][w] = true
+
+	return snapshot, w, nil
+}
+
+func (w *autoscalerEventWatch) ResultChan() <-chan types.AutoscalerEvent {
+	return w.c
+}
+
+func (w *autoscalerEventWatch) Stop() {
+	w.client.Lock()
+	defer w.client.Unlock()
+
+	w.stopLocked()
+}
+
+func (w *autoscalerEventWatch) stopLocked() {
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	close(w.c)
+	delete(w.client.eventWatchesByNamespace[w.namespace], w)
+}
+
+func (w *autoscalerEventWatch) sendLocked(eventType watch.EventType, autoscaler *prototypes.Autoscaler) {
+	if w.stopped {
+		return
+	}
+	select {
+	case w.c <- types.AutoscalerEvent{
+		Type:       eventType,
+		Autoscaler: proto.Clone(autoscaler).(*prototypes.Autoscaler),
+	}:
+	default:
+		klog.InfoS("autoscaler watch fell behind, closing", "namespace", w.namespace)
+		w.stopLocked()
+	}
+}
+
+func (c *Client) updateEventWatchesLocked(eventType watch.EventType, entry *autoscalerEntry) {
+	namespace := entry.autoscaler.Namespace
+	if namespace != v1.NamespaceAll {
+		for w := range c.eventWatchesByNamespace[namespace] {
+			w.sendLocked(eventType, entry.autoscaler)
+		}
+	}
+	for w := range c.eventWatchesByNamespace[v1.NamespaceAll] {
+		w.sendLocked(eventType, entry.autoscaler)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"testing"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage/mocks"
+	"k9s-autoscaler/pkg/storage/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestClientWatchAutoscalers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	client, err := NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer client.Close()
+
+	newAutoscaler := func(name, namespace string) *prototypes.Autoscaler {
+		return &prototypes.Autoscaler{
+			Name:      name,
+			Namespace: namespace,
+			Spec: &prototypes.AutoscalerSpec{
+				Min:     1,
+				Max:     2,
+				Metrics: []*prototypes.Metric{{Name: "testmetric", Target: 1}},
+			},
+		}
+	}
+	err = client.Add(newAutoscaler("testas1", "testns1"))
+	require.NoError(t, err)
+	err = client.Add(newAutoscaler("testas2", "testns2"))
+	require.NoError(t, err)
+
+	snapshot, nsWatch, err := client.WatchAutoscalers("testns1")
+	require.NoError(t, err)
+	defer nsWatch.Stop()
+	require.Len(t, snapshot, 1)
+	require.Equal(t, "testas1", snapshot[0].Name)
+
+	snapshot, allWatch, err := client.WatchAutoscalers("")
+	require.NoError(t, err)
+	defer allWatch.Stop()
+	require.Len(t, snapshot, 2)
+
+	err = client.Add(newAutoscaler("testas3", "testns2"))
+	require.NoError(t, err)
+	updated := newAutoscaler("testas1", "testns1")
+	updated.Spec.Max = 5
+	err = client.Update(updated)
+	require.NoError(t, err)
+	err = client.Delete("testas1", "testns1")
+	require.NoError(t, err)
+
+	requireEvent(t, nsWatch, watch.Modified, "testas1")
+	requireEvent(t, nsWatch, watch.Deleted, "testas1")
+	requireEvent(t, allWatch, watch.Added, "testas3")
+	e := requireEvent(t, allWatch, watch.Modified, "testas1")
+	require.EqualValues(t, 5, e.Autoscaler.Spec.Max)
+	requireEvent(t, allWatch, watch.Deleted, "testas1")
+
+	// stopped watches are closed and no longer receive events
+	nsWatch.Stop()
+	_, ok := <-nsWatch.ResultChan()
+	require.False(t, ok)
+	nsWatch.Stop()
+	err = client.Add(newAutoscaler("testas1", "testns1"))
+	require.NoError(t, err)
+	requireEvent(t, allWatch, watch.Added, "testas1")
+
+	// slow watches are closed
+	for i := 0; i <= AutoscalerWatchBufferSize; i++ {
+		updated.Spec.Max = int32(i + 2)
+		err = client.Update(updated)
+		require.NoError(t, err)
+	}
+	count := 0
+	for range allWatch.ResultChan() {
+		count++
+	}
+	require.Equal(t, AutoscalerWatchBufferSize, count)
+}
+
+func requireEvent(t *testing.T, w types.AutoscalerWatch, eventType watch.EventType, name string) types.AutoscalerEvent {
+	select {
+	case e, ok := <-w.ResultChan():
+		require.True(t, ok)
+		require.Equal(t, eventType, e.Type)
+		require.Equal(t, name, e.Autoscaler.Name)
+		return e
+	case <-time.After(time.Second):
+		require.Fail(t, "timed out waiting for event")
+	}
+	return types.AutoscalerEvent{}
+}
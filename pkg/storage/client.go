@@ -4,6 +4,7 @@ package storage
 
 import (
 	"fmt"
+	"math"
 	"path/filepath"
 	"sync"
 	"time"
@@ -19,13 +20,23 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	apiv2 "k8s.io/client-go/kubernetes/typed/autoscaling/v2"
 	"k8s.io/klog/v2"
 )
 
+var (
+	_ types.AutoscalerCRUDder         = &Client{}
+	_ types.AutoscalerStatusGetter    = &Client{}
+	_ types.AutoscalerConditionSetter = &Client{}
+)
+
 type autoscalerEntry struct {
 	autoscaler *prototypes.Autoscaler
 	hpa        *v2.HorizontalPodAutoscaler
+	history    *statusHistory
+	// conditions not managed by the HPA, by type.
+	conditions map[prototypes.Condition_ConditionType]*prototypes.Condition
 }
 
 // An autoscaler client that implements storage mapping between K9s and K8s
@@ -39,23 +50,57 @@ type Client struct {
 	watchersByNamespace       map[string]types.AutoscalerStatusUpdateHandler
 	watchesByNamespace        map[string]map[*autoscalerWatch]bool
 	watchesByWatchNamespace   map[*autoscalerWatch]string
+	eventWatchesByNamespace   map[string]map[*autoscalerEventWatch]bool
+	closers                   []func()
 }
 
 // Create a new client that uses statusUpdateHandler to propagate changes in
-// underlying autoscaler status by the HPA.
+// underlying autoscaler status by the HPA. Metrics of autoscalers are exported
+// until the client is closed. Only one such client may exist at a time.
 func NewClient(statusUpdatedHandler types.AutoscalerStatusUpdateHandler) (*Client, error) {
-	c := &Client{
+	c := NewClientWithoutMetrics(statusUpdatedHandler)
+	unregister, err := metrics.RegisterMetricsCollector(c)
+	if err != nil {
+		return nil, err
+	}
+	c.OnClose(unregister)
+
+	return c, nil
+}
+
+// Create a new client like NewClient that does not export metrics of its
+// autoscalers. Used to run autoscalers in-process alongside a controller, such
+// as in backtests.
+func NewClientWithoutMetrics(statusUpdatedHandler types.AutoscalerStatusUpdateHandler) *Client {
+	return &Client{
 		statusUpdateHandler:       statusUpdatedHandler,
 		autoscalerByNamespaceName: make(map[string]map[string]*autoscalerEntry),
 		watchersByNamespace:       make(map[string]types.AutoscalerStatusUpdateHandler),
 		watchesByNamespace:        make(map[string]map[*autoscalerWatch]bool),
 		watchesByWatchNamespace:   make(map[*autoscalerWatch]string),
+		eventWatchesByNamespace:   make(map[string]map[*autoscalerEventWatch]bool),
 	}
-	if err := metrics.RegisterMetricsCollector(c); err != nil {
-		return nil, err
-	}
+}
 
-	return c, nil
+// Registers closer to be called by Close(). Used by providers to stop their
+// background work, such as watching or polling for autoscalers.
+func (c *Client) OnClose(closer func()) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.closers = append(c.closers, closer)
+}
+
+// Closes the client by calling all registered closers in reverse order.
+func (c *Client) Close() {
+	c.Lock()
+	closers := c.closers
+	c.closers = nil
+	c.Unlock()
+
+	for i := len(closers) - 1; i >= 0; i-- {
+		closers[i]()
+	}
 }
 
 // Implement HorizontalPodAutoscalersGetter k8s interface.
@@ -82,21 +127,58 @@ func (c *Client) List() ([]*prototypes.Autoscaler, error) {
 	return autoscalers, nil
 }
 
-// Get an autoscaler by name and namespace.
+// Get an autoscaler by name and namespace. Returned object is a clone of the
+// internal storage.
 func (c *Client) Get(name, namespace string) (*prototypes.Autoscaler, error) {
 	c.RLock()
 	defer c.RUnlock()
 
-	if autoscalersByName, ok := c.autoscalerByNamespaceName[namespace]; ok {
-		if autoscaler, ok := autoscalersByName[name]; ok {
-			return autoscaler.autoscaler, nil
-		}
+	entry, err := c.getEntryLocked(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(entry.autoscaler).(*prototypes.Autoscaler), nil
+}
+
+// Get current status of an autoscaler by name and namespace. An empty status
+// is returned if the autoscaler has not been processed yet.
+// Implements AutoscalerStatusGetter.
+func (c *Client) GetStatus(name, namespace string) (*prototypes.AutoscalerStatus, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	entry, err := c.getEntryLocked(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if entry.autoscaler.Status == nil {
+		return &prototypes.AutoscalerStatus{}, nil
 	}
 
-	return nil, fmt.Errorf("autoscaler %s namespace %s not found", name, namespace)
+	return proto.Clone(entry.autoscaler.Status).(*prototypes.AutoscalerStatus), nil
 }
 
-// Adds a new autoscaler. All defined k8s watches will be notified.
+// Get up to StatusHistorySize recent status snapshots of an autoscaler by
+// name and namespace, ordered from oldest to newest. History is retained
+// across autoscaler updates and dropped on delete.
+// Implements AutoscalerStatusGetter.
+func (c *Client) GetStatusHistory(name, namespace string) ([]*types.AutoscalerStatusSnapshot, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	entry, err := c.getEntryLocked(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.history.list(), nil
+}
+
+// Adds a new autoscaler. All defined k8s watches will be notified. Returns
+// an AlreadyExists error if an autoscaler with same name and namespace exists,
+// or a BadRequest error if autoscaler is invalid. A clone of autoscaler is
+// stored, so callers may keep using it.
 // Implements AutoscalerCRUDder.
 func (c *Client) Add(autoscaler *prototypes.Autoscaler) error {
 	klog.V(0).InfoS("adding new autoscaler", "autoscaler", autoscaler)
@@ -108,16 +190,18 @@ func (c *Client) Add(autoscaler *prototypes.Autoscaler) error {
 		c.autoscalerByNamespaceName[autoscaler.Namespace] = make(map[string]*autoscalerEntry)
 	}
 	if _, ok := c.autoscalerByNamespaceName[autoscaler.Namespace][autoscaler.Name]; ok {
-		return fmt.Errorf("already exists: %s", autoscaler.Name)
+		return errors.NewAlreadyExists(v2.Resource("horizontalpodautoscaler"), autoscaler.Name)
 	}
 
 	hpa, err := autoscalerToHPA(autoscaler)
 	if err != nil {
-		return err
+		return errors.NewBadRequest(err.Error())
 	}
 	entry := &autoscalerEntry{
-		autoscaler: autoscaler,
+		autoscaler: proto.Clone(autoscaler).(*prototypes.Autoscaler),
 		hpa:        hpa,
+		history:    newStatusHistory(StatusHistorySize),
+		conditions: make(map[prototypes.Condition_ConditionType]*prototypes.Condition),
 	}
 	c.autoscalerByNamespaceName[autoscaler.Namespace][autoscaler.Name] = entry
 
@@ -127,6 +211,9 @@ func (c *Client) Add(autoscaler *prototypes.Autoscaler) error {
 }
 
 // Updates an existing autoscaler. All defined k8s watches will be notified.
+// Returns a NotFound error if autoscaler does not exist, or a BadRequest error
+// if autoscaler is invalid. A clone of autoscaler is stored, so callers may
+// keep using it.
 // Implements AutoscalerCRUDder.
 func (c *Client) Update(autoscaler *prototypes.Autoscaler) error {
 	klog.V(0).InfoS("updating autoscaler", "autoscaler", autoscaler)
@@ -137,17 +224,20 @@ func (c *Client) Update(autoscaler *prototypes.Autoscaler) error {
 	if _, ok := c.autoscalerByNamespaceName[autoscaler.Namespace]; !ok {
 		return errors.NewNotFound(v2.Resource("horizontalpodautoscaler"), autoscaler.Name)
 	}
-	if _, ok := c.autoscalerByNamespaceName[autoscaler.Namespace][autoscaler.Name]; !ok {
+	existing, ok := c.autoscalerByNamespaceName[autoscaler.Namespace][autoscaler.Name]
+	if !ok {
 		return errors.NewNotFound(v2.Resource("horizontalpodautoscaler"), autoscaler.Name)
 	}
 
 	hpa, err := autoscalerToHPA(autoscaler)
 	if err != nil {
-		return err
+		return errors.NewBadRequest(err.Error())
 	}
 	entry := &autoscalerEntry{
-		autoscaler: autoscaler,
+		autoscaler: proto.Clone(autoscaler).(*prototypes.Autoscaler),
 		hpa:        hpa,
+		history:    existing.history,
+		conditions: existing.conditions,
 	}
 	c.autoscalerByNamespaceName[autoscaler.Namespace][autoscaler.Name] = entry
 
@@ -171,6 +261,7 @@ func (c *Client) Delete(name, namespace string) error {
 		return errors.NewNotFound(v2.Resource("horizontalpodautoscaler"), name)
 	} else {
 		delete(c.autoscalerByNamespaceName[namespace], name)
+		metrics.DeleteAutoscalerMetrics(name, namespace)
 
 		c.updateWatchesDeletedLocked(entry)
 	}
@@ -178,19 +269,87 @@ func (c *Client) Delete(name, namespace string) error {
 	return nil
 }
 
+// Sets a condition that is not managed by the HPA, replacing any existing
+// condition of the same type. Condition transition time is updated only if
+// its status changes. The condition is kept across HPA status updates.
+// Implements AutoscalerConditionSetter.
+func (c *Client) SetCondition(name, namespace string, condition *prototypes.Condition) error {
+	klog.V(1).InfoS("setting condition", "name", name, "namespace", namespace, "condition", condition)
+
+	c.Lock()
+	defer c.Unlock()
+
+	entry, err := c.getEntryLocked(name, namespace)
+	if err != nil {
+		return err
+	}
+
+	condition = proto.Clone(condition).(*prototypes.Condition)
+	if existing, ok := entry.conditions[condition.Type]; ok && existing.Status == condition.Status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	} else {
+		condition.LastTransitionTime = timestamppb.Now()
+	}
+	entry.conditions[condition.Type] = condition
+
+	status := &prototypes.AutoscalerStatus{}
+	if entry.autoscaler.Status != nil {
+		status = proto.Clone(entry.autoscaler.Status).(*prototypes.AutoscalerStatus)
+	}
+	conditions := []*prototypes.Condition{}
+	for _, existing := range status.Conditions {
+		if existing.Type != condition.Type {
+			conditions = append(conditions, existing)
+		}
+	}
+	status.Conditions = append(conditions, condition)
+	c.updateStatusLocked(entry, status)
+
+	return nil
+}
+
+// Sets status of entry and notifies status handlers and watches.
+func (c *Client) updateStatusLocked(entry *autoscalerEntry, status *prototypes.AutoscalerStatus) {
+	entry.autoscaler.Status = status
+	entry.history.add(time.Now(), status)
+
+	if watcher, ok := c.watchersByNamespace[entry.autoscaler.Namespace]; ok {
+		go func() {
+			watcher.AutoscalerStatusUpdated(entry.autoscaler)
+		}()
+	}
+
+	c.statusUpdateHandler.AutoscalerStatusUpdated(entry.autoscaler)
+
+	c.updateWatchesModifiedLocked(entry)
+}
+
+func (c *Client) getEntryLocked(name, namespace string) (*autoscalerEntry, error) {
+	if autoscalersByName, ok := c.autoscalerByNamespaceName[namespace]; ok {
+		if entry, ok := autoscalersByName[name]; ok {
+			return entry, nil
+		}
+	}
+
+	return nil, errors.NewNotFound(v2.Resource("horizontalpodautoscaler"), name)
+}
+
 func (c *Client) updateWatchesAddedLocked(entry *autoscalerEntry) {
+	c.updateEventWatchesLocked(watch.Added, entry)
 	c.updatedWatchesLocked(entry.autoscaler.Namespace, func(w *autoscalerWatch) {
 		w.add(entry.hpa)
 	})
 }
 
 func (c *Client) updateWatchesModifiedLocked(entry *autoscalerEntry) {
+	c.updateEventWatchesLocked(watch.Modified, entry)
 	c.updatedWatchesLocked(entry.autoscaler.Namespace, func(w *autoscalerWatch) {
 		w.update(entry.hpa)
 	})
 }
 
 func (c *Client) updateWatchesDeletedLocked(entry *autoscalerEntry) {
+	c.updateEventWatchesLocked(watch.Deleted, entry)
 	c.updatedWatchesLocked(entry.autoscaler.Namespace, func(w *autoscalerWatch) {
 		w.delete(entry.hpa)
 	})
@@ -211,6 +370,30 @@ func (c *Client) updatedWatchesLocked(namespace string, watchFunc func(*autoscal
 	}
 }
 
+// Returns metric target as a quantity. Target must be > 0.
+func metricTargetQuantity(metric *prototypes.Metric) (*resource.Quantity, error) {
+	var target resource.Quantity
+	if len(metric.TargetQuantity) > 0 {
+		var err error
+		target, err = resource.ParseQuantity(metric.TargetQuantity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target quantity %s for metric %s: %v", metric.TargetQuantity, metric.Name, err)
+		}
+	} else if metric.TargetValue != nil {
+		if math.IsNaN(*metric.TargetValue) || math.IsInf(*metric.TargetValue, 0) {
+			return nil, fmt.Errorf("invalid target value %v for metric %s", *metric.TargetValue, metric.Name)
+		}
+		target = *resource.NewMilliQuantity(int64(math.Round(*metric.TargetValue*1000)), resource.DecimalSI)
+	} else {
+		target = *resource.NewQuantity(metric.Target, resource.DecimalSI)
+	}
+	if target.Sign() <= 0 {
+		return nil, fmt.Errorf("target must be > 0 for metric %s", metric.Name)
+	}
+
+	return &target, nil
+}
+
 func autoscalerToHPA(autoscaler *prototypes.Autoscaler) (*v2.HorizontalPodAutoscaler, error) {
 	if len(autoscaler.Name) == 0 {
 		return nil, fmt.Errorf("name is required")
@@ -221,10 +404,31 @@ func autoscalerToHPA(autoscaler *prototypes.Autoscaler) (*v2.HorizontalPodAutosc
 	if autoscaler.Spec == nil || len(autoscaler.Spec.Metrics) == 0 {
 		return nil, fmt.Errorf("no metrics")
 	}
+	if fallback := autoscaler.Spec.Fallback; fallback != nil {
+		if fallback.FailureThreshold <= 0 {
+			return nil, fmt.Errorf("fallback failure threshold must be > 0")
+		}
+		if fallback.Replicas < autoscaler.Spec.Min || fallback.Replicas > autoscaler.Spec.Max {
+			return nil, fmt.Errorf("fallback replicas %d must be within min %d and max %d", fallback.Replicas, autoscaler.Spec.Min, autoscaler.Spec.Max)
+		}
+	}
 
 	metrics := make([]v2.MetricSpec, len(autoscaler.Spec.Metrics))
 	for i := 0; i < len(autoscaler.Spec.Metrics); i++ {
 		metric := autoscaler.Spec.Metrics[i]
+		target, err := metricTargetQuantity(metric)
+		if err != nil {
+			return nil, err
+		}
+		var metricTarget v2.MetricTarget
+		switch metric.TargetType {
+		case prototypes.Metric_Value:
+			metricTarget = v2.MetricTarget{Type: v2.ValueMetricType, Value: target}
+		case prototypes.Metric_AverageValue:
+			metricTarget = v2.MetricTarget{Type: v2.AverageValueMetricType, AverageValue: target}
+		default:
+			return nil, fmt.Errorf("unknown target type %v for metric %s", metric.TargetType, metric.Name)
+		}
 		metrics[i] = v2.MetricSpec{
 			Type: v2.ExternalMetricSourceType,
 			External: &v2.ExternalMetricSource{
@@ -232,10 +436,7 @@ func autoscalerToHPA(autoscaler *prototypes.Autoscaler) (*v2.HorizontalPodAutosc
 					Name:     metric.Name,
 					Selector: EncodeMetricHPA(autoscaler.Name),
 				},
-				Target: v2.MetricTarget{
-					Type:  v2.ValueMetricType,
-					Value: resource.NewQuantity(metric.Target, resource.DecimalSI),
-				},
+				Target: metricTarget,
 			},
 		}
 	}
@@ -4,14 +4,17 @@ package storage
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
 	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage/metrics"
 	"k9s-autoscaler/pkg/storage/mocks"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	v2 "k8s.io/api/autoscaling/v2"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +31,7 @@ func TestClientCRUD(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	autoscaler := prototypes.Autoscaler{
 		Name:      "testas",
@@ -53,6 +57,13 @@ func TestClientCRUD(t *testing.T) {
 	require.Equal(t, autoscaler.Namespace, hpa.Namespace)
 	require.EqualValues(t, autoscaler.Spec.Min, *hpa.Spec.MinReplicas)
 
+	// stored autoscaler is a clone
+	autoscaler.Spec.Max = 3
+	stored, err := client.Get(autoscaler.Name, autoscaler.Namespace)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, stored.Spec.Max)
+	autoscaler.Spec.Max = 2
+
 	// update
 	autoscaler.Spec.Min = 10
 	err = client.Update(&autoscaler)
@@ -62,11 +73,14 @@ func TestClientCRUD(t *testing.T) {
 	require.EqualValues(t, autoscaler.Spec.Min, *hpa.Spec.MinReplicas)
 
 	// delete
+	metrics.MetricAgeMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace, "testmetric").Set(1)
 	err = client.Delete(autoscaler.Name, autoscaler.Namespace)
 	require.NoError(t, err)
 	_, err = client.HorizontalPodAutoscalers(autoscaler.Namespace).Get(context.Background(), autoscaler.Name, v1.GetOptions{})
 	require.Error(t, err)
 	require.True(t, errors.IsNotFound(err))
+	// metric series of deleted autoscaler are removed
+	require.False(t, metrics.MetricAgeMetric.DeleteLabelValues(autoscaler.Name, autoscaler.Namespace, "testmetric"))
 }
 
 func TestClientK8sCacheOperationsBefore(t *testing.T) {
@@ -76,6 +90,7 @@ func TestClientK8sCacheOperationsBefore(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
@@ -132,6 +147,7 @@ func TestClientK8sCacheOperationsAfter(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
@@ -213,3 +229,149 @@ func TestClientK8sCacheOperationsAfter(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, exists)
 }
+
+func TestMetricTargetQuantity(t *testing.T) {
+	target, err := metricTargetQuantity(&prototypes.Metric{Name: "testmetric", Target: 70})
+	require.NoError(t, err)
+	require.EqualValues(t, 70000, target.MilliValue())
+	target, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", TargetValue: proto.Float64(0.25)})
+	require.NoError(t, err)
+	require.EqualValues(t, 250, target.MilliValue())
+	target, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", Target: 1, TargetValue: proto.Float64(0.5)})
+	require.NoError(t, err)
+	require.EqualValues(t, 500, target.MilliValue())
+	target, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", Target: 1, TargetQuantity: "1.5k"})
+	require.NoError(t, err)
+	require.EqualValues(t, 1500000, target.MilliValue())
+	target, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", TargetQuantity: "250m"})
+	require.NoError(t, err)
+	require.EqualValues(t, 250, target.MilliValue())
+
+	_, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric"})
+	require.Error(t, err)
+	_, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", Target: -1})
+	require.Error(t, err)
+	_, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", TargetValue: proto.Float64(math.NaN())})
+	require.Error(t, err)
+	_, err = metricTargetQuantity(&prototypes.Metric{Name: "testmetric", TargetQuantity: "invalid"})
+	require.Error(t, err)
+}
+
+func TestAutoscalerToHPATargetType(t *testing.T) {
+	autoscaler := &prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testns",
+		Spec: &prototypes.AutoscalerSpec{
+			Max: 10,
+			Metrics: []*prototypes.Metric{
+				{Name: "metric1", Target: 70},
+				{Name: "metric2", TargetValue: proto.Float64(0.5), TargetType: prototypes.Metric_AverageValue},
+			},
+		},
+	}
+	hpa, err := autoscalerToHPA(autoscaler)
+	require.NoError(t, err)
+	require.Len(t, hpa.Spec.Metrics, 2)
+	require.Equal(t, v2.ValueMetricType, hpa.Spec.Metrics[0].External.Target.Type)
+	require.EqualValues(t, 70000, hpa.Spec.Metrics[0].External.Target.Value.MilliValue())
+	require.Nil(t, hpa.Spec.Metrics[0].External.Target.AverageValue)
+	require.Equal(t, v2.AverageValueMetricType, hpa.Spec.Metrics[1].External.Target.Type)
+	require.EqualValues(t, 500, hpa.Spec.Metrics[1].External.Target.AverageValue.MilliValue())
+	require.Nil(t, hpa.Spec.Metrics[1].External.Target.Value)
+
+	autoscaler.Spec.Metrics[0].TargetType = 5
+	_, err = autoscalerToHPA(autoscaler)
+	require.Error(t, err)
+}
+
+func TestClientSetCondition(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	client, err := NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer client.Close()
+
+	autoscaler := prototypes.Autoscaler{
+		Name:      "testas",
+		Namespace: "testasns",
+		Spec: &prototypes.AutoscalerSpec{
+			Min:      1,
+			Max:      2,
+			Metrics:  []*prototypes.Metric{{Name: "testmetric", Target: 1}},
+			Fallback: &prototypes.Fallback{FailureThreshold: 3, Replicas: 2},
+		},
+	}
+	err = client.Add(&autoscaler)
+	require.NoError(t, err)
+	err = client.SetCondition("testas", "otherns", &prototypes.Condition{Type: prototypes.Condition_FallbackActive})
+	require.Error(t, err)
+
+	err = client.SetCondition(autoscaler.Name, autoscaler.Namespace, &prototypes.Condition{
+		Type:   prototypes.Condition_FallbackActive,
+		Status: "True",
+	})
+	require.NoError(t, err)
+	status, err := client.GetStatus(autoscaler.Name, autoscaler.Namespace)
+	require.NoError(t, err)
+	require.Len(t, status.Conditions, 1)
+	transitionTime := status.Conditions[0].LastTransitionTime
+	require.NotNil(t, transitionTime)
+
+	// condition is kept across HPA status updates
+	_, err = client.HorizontalPodAutoscalers(autoscaler.Namespace).UpdateStatus(
+		context.Background(),
+		&v2.HorizontalPodAutoscaler{
+			ObjectMeta: v1.ObjectMeta{Name: autoscaler.Name, Namespace: autoscaler.Namespace},
+			Status: v2.HorizontalPodAutoscalerStatus{
+				Conditions: []v2.HorizontalPodAutoscalerCondition{{Type: v2.ScalingActive, Status: "False"}},
+			},
+		},
+		v1.UpdateOptions{})
+	require.NoError(t, err)
+	status, err = client.GetStatus(autoscaler.Name, autoscaler.Namespace)
+	require.NoError(t, err)
+	require.Len(t, status.Conditions, 2)
+	require.Equal(t, prototypes.Condition_ScalingActive, status.Conditions[0].Type)
+	require.Equal(t, prototypes.Condition_FallbackActive, status.Conditions[1].Type)
+
+	// transition time only changes with status
+	err = client.SetCondition(autoscaler.Name, autoscaler.Namespace, &prototypes.Condition{
+		Type:    prototypes.Condition_FallbackActive,
+		Status:  "True",
+		Message: "updated",
+	})
+	require.NoError(t, err)
+	status, err = client.GetStatus(autoscaler.Name, autoscaler.Namespace)
+	require.NoError(t, err)
+	require.Len(t, status.Conditions, 2)
+	require.Equal(t, "updated", status.Conditions[1].Message)
+	require.Equal(t, transitionTime.AsTime(), status.Conditions[1].LastTransitionTime.AsTime())
+
+	// invalid fallback
+	autoscaler.Spec.Fallback = &prototypes.Fallback{FailureThreshold: 0, Replicas: 2}
+	require.Error(t, client.Update(&autoscaler))
+	autoscaler.Spec.Fallback = &prototypes.Fallback{FailureThreshold: 1, Replicas: 3}
+	require.Error(t, client.Update(&autoscaler))
+}
+
+func TestClientMetricsRegistration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	client, err := NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+
+	// metrics of a live client are not taken over
+	_, err = NewClient(statusUpdateHandler)
+	require.Error(t, err)
+	require.NotNil(t, NewClientWithoutMetrics(statusUpdateHandler))
+
+	client.Close()
+	client, err = NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	client.Close()
+}
@@ -0,0 +1,70 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage/types"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Maximum number of status snapshots retained per autoscaler.
+	StatusHistorySize = 100
+)
+
+// A bounded ring buffer of autoscaler status snapshots. Once full, oldest
+// snapshots are overwritten. Not thread safe.
+type statusHistory struct {
+	snapshots []types.AutoscalerStatusSnapshot
+	next      int
+	full      bool
+}
+
+func newStatusHistory(size int) *statusHistory {
+	return &statusHistory{
+		snapshots: make([]types.AutoscalerStatusSnapshot, size),
+	}
+}
+
+// Adds a snapshot of status at t. Status equal to the newest snapshot is
+// skipped so that periodic resyncs do not fill the history with duplicates.
+func (h *statusHistory) add(t time.Time, status *prototypes.AutoscalerStatus) {
+	if h.next > 0 || h.full {
+		last := h.snapshots[(h.next-1+len(h.snapshots))%len(h.snapshots)]
+		if proto.Equal(last.Status, status) {
+			return
+		}
+	}
+
+	h.snapshots[h.next] = types.AutoscalerStatusSnapshot{
+		Time:   t,
+		Status: status,
+	}
+	h.next = (h.next + 1) % len(h.snapshots)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// Returns clones of retained snapshots ordered from oldest to newest.
+func (h *statusHistory) list() []*types.AutoscalerStatusSnapshot {
+	start, count := 0, h.next
+	if h.full {
+		start, count = h.next, len(h.snapshots)
+	}
+
+	list := make([]*types.AutoscalerStatusSnapshot, count)
+	for i := 0; i < count; i++ {
+		snapshot := h.snapshots[(start+i)%len(h.snapshots)]
+		list[i] = &types.AutoscalerStatusSnapshot{
+			Time:   snapshot.Time,
+			Status: proto.Clone(snapshot.Status).(*prototypes.AutoscalerStatus),
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,48 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"testing"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusHistory(t *testing.T) {
+	history := newStatusHistory(3)
+	require.Empty(t, history.list())
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		history.add(now.Add(time.Duration(i)*time.Second), &prototypes.AutoscalerStatus{DesiredScale: int32(i)})
+	}
+	list := history.list()
+	require.Len(t, list, 2)
+	require.EqualValues(t, 0, list[0].Status.DesiredScale)
+	require.EqualValues(t, 1, list[1].Status.DesiredScale)
+
+	// wrap around
+	for i := 2; i < 5; i++ {
+		history.add(now.Add(time.Duration(i)*time.Second), &prototypes.AutoscalerStatus{DesiredScale: int32(i)})
+	}
+	list = history.list()
+	require.Len(t, list, 3)
+	for i, snapshot := range list {
+		require.EqualValues(t, i+2, snapshot.Status.DesiredScale)
+		require.Equal(t, now.Add(time.Duration(i+2)*time.Second), snapshot.Time)
+	}
+
+	// unchanged status is skipped
+	history.add(now.Add(5*time.Second), &prototypes.AutoscalerStatus{DesiredScale: 4})
+	list = history.list()
+	require.Len(t, list, 3)
+	require.EqualValues(t, 2, list[0].Status.DesiredScale)
+	require.Equal(t, now.Add(4*time.Second), list[2].Time)
+
+	// returned snapshots are clones
+	list[0].Status.DesiredScale = 100
+	require.EqualValues(t, 2, history.list()[0].Status.DesiredScale)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"context"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	v2listers "k8s.io/client-go/listers/autoscaling/v2"
+)
+
+var (
+	_ v2listers.HorizontalPodAutoscalerLister          = &directLister{}
+	_ v2listers.HorizontalPodAutoscalerNamespaceLister = &directLister{}
+)
+
+// A HorizontalPodAutoscalerLister that reads directly from storage rather
+// than from an informer cache, such that reads reflect all prior updates.
+type directLister struct {
+	storageClient *Client
+	namespace     string
+}
+
+// Create a new lister of HPAs in storageClient that does not lag behind
+// updates.
+func NewDirectLister(storageClient *Client) v2listers.HorizontalPodAutoscalerLister {
+	return &directLister{
+		storageClient: storageClient,
+		namespace:     v1.NamespaceAll,
+	}
+}
+
+func (l *directLister) List(selector labels.Selector) ([]*v2.HorizontalPodAutoscaler, error) {
+	list, err := l.storageClient.HorizontalPodAutoscalers(l.namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	hpas := []*v2.HorizontalPodAutoscaler{}
+	for i := range list.Items {
+		if selector.Matches(labels.Set(list.Items[i].Labels)) {
+			hpas = append(hpas, &list.Items[i])
+		}
+	}
+
+	return hpas, nil
+}
+
+func (l *directLister) HorizontalPodAutoscalers(namespace string) v2listers.HorizontalPodAutoscalerNamespaceLister {
+	return &directLister{
+		storageClient: l.storageClient,
+		namespace:     namespace,
+	}
+}
+
+func (l *directLister) Get(name string) (*v2.HorizontalPodAutoscaler, error) {
+	return l.storageClient.HorizontalPodAutoscalers(l.namespace).Get(context.TODO(), name, v1.GetOptions{})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package storage
+
+import (
+	"context"
+	"testing"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/storage/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestDirectLister(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
+	statusUpdateHandler.EXPECT().AutoscalerStatusUpdated(gomock.Any()).AnyTimes()
+	client, err := NewClient(statusUpdateHandler)
+	require.NoError(t, err)
+	defer client.Close()
+
+	for _, namespace := range []string{"testns1", "testns2"} {
+		require.NoError(t, client.Add(&prototypes.Autoscaler{
+			Name:      "testas",
+			Namespace: namespace,
+			Spec: &prototypes.AutoscalerSpec{
+				Min:     1,
+				Max:     2,
+				Metrics: []*prototypes.Metric{{Name: "testmetric", Target: 1}},
+			},
+		}))
+	}
+
+	lister := NewDirectLister(client)
+	hpas, err := lister.List(labels.Everything())
+	require.NoError(t, err)
+	require.Len(t, hpas, 2)
+	hpas, err = lister.HorizontalPodAutoscalers("testns1").List(labels.Everything())
+	require.NoError(t, err)
+	require.Len(t, hpas, 1)
+	require.Equal(t, "testns1", hpas[0].Namespace)
+
+	// status updates are seen immediately
+	_, err = client.HorizontalPodAutoscalers("testns1").UpdateStatus(
+		context.Background(),
+		&v2.HorizontalPodAutoscaler{
+			ObjectMeta: v1.ObjectMeta{Name: "testas", Namespace: "testns1"},
+			Status:     v2.HorizontalPodAutoscalerStatus{DesiredReplicas: 2},
+		},
+		v1.UpdateOptions{})
+	require.NoError(t, err)
+	hpa, err := lister.HorizontalPodAutoscalers("testns1").Get("testas")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, hpa.Status.DesiredReplicas)
+
+	_, err = lister.HorizontalPodAutoscalers("testns3").Get("testas")
+	require.True(t, errors.IsNotFound(err))
+}
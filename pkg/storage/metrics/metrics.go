@@ -10,14 +10,17 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	autoscalingapiv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	autoscalingv2 "k8s.io/client-go/kubernetes/typed/autoscaling/v2"
 	"k8s.io/klog/v2"
 )
 
 const (
-	metricNameLabel = "metric"
+	metricNameLabel       = "metric"
+	metricTargetTypeLabel = "targetType"
 )
 
 var (
@@ -30,8 +33,27 @@ var (
 			Buckets:   prometheus.ExponentialBucketsRange(0.001, 5.0, 10),
 		},
 		[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, common.MetricsErrorLabel})
+	// Age of the last value of a metric. Set by the metrics client and
+	// deleted by storage when the autoscaler is deleted.
+	MetricAgeMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: common.MetricsNamespace,
+			Subsystem: "metrics",
+			Name:      "metric_age_seconds",
+			Help:      "Age of the last value of a metric.",
+		},
+		[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, metricNameLabel})
 )
 
+// Deletes metric series of autoscaler name in namespace that are not emitted
+// by the collector.
+func DeleteAutoscalerMetrics(name, namespace string) {
+	MetricAgeMetric.DeletePartialMatch(prometheus.Labels{
+		common.MetricsAutoscaleNameLabel:       name,
+		common.MetricsAutoscalerNamespaceLabel: namespace,
+	})
+}
+
 var (
 	_ prometheus.Collector = &metricsCollector{}
 )
@@ -53,8 +75,10 @@ type metricsCollector struct {
 }
 
 // Creates and registers a new Collector for storage metrics that uses getter
-// to obtain a list of available autoscalers.
-func RegisterMetricsCollector(getter autoscalingv2.HorizontalPodAutoscalersGetter) error {
+// to obtain a list of available autoscalers. Returns a function that
+// unregisters the collector. If a collector is already registered, returns
+// prometheus.AlreadyRegisteredError.
+func RegisterMetricsCollector(getter autoscalingv2.HorizontalPodAutoscalersGetter) (func(), error) {
 	collector := metricsCollector{
 		getter: getter,
 
@@ -81,7 +105,7 @@ func RegisterMetricsCollector(getter autoscalingv2.HorizontalPodAutoscalersGette
 				Name:      "metric_target",
 				Help:      "Metric scale target.",
 			},
-			[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, metricNameLabel}),
+			[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, metricNameLabel, metricTargetTypeLabel}),
 		scaleStateDesiredMetric: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Namespace: common.MetricsNamespace,
@@ -103,12 +127,18 @@ func RegisterMetricsCollector(getter autoscalingv2.HorizontalPodAutoscalersGette
 				Namespace: common.MetricsNamespace,
 				Subsystem: "status",
 				Name:      "metric_value",
-				Help:      "Current metric value.",
+				Help:      "Current metric value. For AverageValue targets, value is divided by current scale.",
 			},
-			[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, metricNameLabel}),
+			[]string{common.MetricsAutoscaleNameLabel, common.MetricsAutoscalerNamespaceLabel, metricNameLabel, metricTargetTypeLabel}),
+	}
+
+	// only one collector may be registered at a time, such that metrics of
+	// one storage client are not taken over by another.
+	if err := prometheus.Register(&collector); err != nil {
+		return nil, err
 	}
 
-	return prometheus.Register(&collector)
+	return func() { prometheus.Unregister(&collector) }, nil
 }
 
 func (c *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
@@ -148,13 +178,30 @@ func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
 		}
 		c.specMaxMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace).Set(float64(autoscaler.Spec.MaxReplicas))
 		for _, metric := range autoscaler.Spec.Metrics {
-			c.specMetricTarget.WithLabelValues(autoscaler.Name, autoscaler.Namespace, metric.External.Metric.Name).Set(metric.External.Target.Value.AsApproximateFloat64())
+			if metric.External == nil {
+				continue
+			}
+			targetType, value := metricValue(metric.External.Target)
+			if value == nil {
+				continue
+			}
+			c.specMetricTarget.WithLabelValues(autoscaler.Name, autoscaler.Namespace, metric.External.Metric.Name, targetType).Set(value.AsApproximateFloat64())
 		}
 
 		c.scaleStateDesiredMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace).Set(float64(autoscaler.Status.DesiredReplicas))
 		c.scaleStateCurrentMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace).Set(float64(autoscaler.Status.CurrentReplicas))
 		for _, metric := range autoscaler.Status.CurrentMetrics {
-			c.metricsCurrentMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace, metric.External.Metric.Name).Set(metric.External.Current.Value.AsApproximateFloat64())
+			if metric.External == nil {
+				continue
+			}
+			targetType, value := metricValue(autoscalingapiv2.MetricTarget{
+				Value:        metric.External.Current.Value,
+				AverageValue: metric.External.Current.AverageValue,
+			})
+			if value == nil {
+				continue
+			}
+			c.metricsCurrentMetric.WithLabelValues(autoscaler.Name, autoscaler.Namespace, metric.External.Metric.Name, targetType).Set(value.AsApproximateFloat64())
 		}
 	}
 
@@ -165,3 +212,12 @@ func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
 	c.scaleStateCurrentMetric.Collect(ch)
 	c.metricsCurrentMetric.Collect(ch)
 }
+
+// Returns the target type and the value set in target.
+func metricValue(target autoscalingapiv2.MetricTarget) (string, *resource.Quantity) {
+	if target.AverageValue != nil {
+		return string(autoscalingapiv2.AverageValueMetricType), target.AverageValue
+	}
+
+	return string(autoscalingapiv2.ValueMetricType), target.Value
+}
@@ -0,0 +1,24 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestMetricValue(t *testing.T) {
+	targetType, value := metricValue(v2.MetricTarget{Type: v2.ValueMetricType, Value: resource.NewMilliQuantity(1500, resource.DecimalSI)})
+	require.Equal(t, "Value", targetType)
+	require.Equal(t, 1.5, value.AsApproximateFloat64())
+
+	targetType, value = metricValue(v2.MetricTarget{Type: v2.AverageValueMetricType, AverageValue: resource.NewQuantity(3, resource.DecimalSI)})
+	require.Equal(t, "AverageValue", targetType)
+	require.Equal(t, 3.0, value.AsApproximateFloat64())
+
+	_, value = metricValue(v2.MetricTarget{})
+	require.Nil(t, value)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	proto "k9s-autoscaler/pkg/proto"
+	types "k9s-autoscaler/pkg/storage/types"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -193,3 +194,181 @@ func (mr *MockAutoscalerStatusUpdateHandlerMockRecorder) AutoscalerStatusUpdated
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoscalerStatusUpdated", reflect.TypeOf((*MockAutoscalerStatusUpdateHandler)(nil).AutoscalerStatusUpdated), autoscaler)
 }
+
+// MockAutoscalerStatusGetter is a mock of AutoscalerStatusGetter interface.
+type MockAutoscalerStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerStatusGetterMockRecorder
+}
+
+// MockAutoscalerStatusGetterMockRecorder is the mock recorder for MockAutoscalerStatusGetter.
+type MockAutoscalerStatusGetterMockRecorder struct {
+	mock *MockAutoscalerStatusGetter
+}
+
+// NewMockAutoscalerStatusGetter creates a new mock instance.
+func NewMockAutoscalerStatusGetter(ctrl *gomock.Controller) *MockAutoscalerStatusGetter {
+	mock := &MockAutoscalerStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscalerStatusGetter) EXPECT() *MockAutoscalerStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// GetStatus mocks base method.
+func (m *MockAutoscalerStatusGetter) GetStatus(name, namespace string) (*proto.AutoscalerStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", name, namespace)
+	ret0, _ := ret[0].(*proto.AutoscalerStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockAutoscalerStatusGetterMockRecorder) GetStatus(name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockAutoscalerStatusGetter)(nil).GetStatus), name, namespace)
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockAutoscalerStatusGetter) GetStatusHistory(name, namespace string) ([]*types.AutoscalerStatusSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", name, namespace)
+	ret0, _ := ret[0].([]*types.AutoscalerStatusSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockAutoscalerStatusGetterMockRecorder) GetStatusHistory(name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockAutoscalerStatusGetter)(nil).GetStatusHistory), name, namespace)
+}
+
+// MockAutoscalerConditionSetter is a mock of AutoscalerConditionSetter interface.
+type MockAutoscalerConditionSetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerConditionSetterMockRecorder
+}
+
+// MockAutoscalerConditionSetterMockRecorder is the mock recorder for MockAutoscalerConditionSetter.
+type MockAutoscalerConditionSetterMockRecorder struct {
+	mock *MockAutoscalerConditionSetter
+}
+
+// NewMockAutoscalerConditionSetter creates a new mock instance.
+func NewMockAutoscalerConditionSetter(ctrl *gomock.Controller) *MockAutoscalerConditionSetter {
+	mock := &MockAutoscalerConditionSetter{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerConditionSetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscalerConditionSetter) EXPECT() *MockAutoscalerConditionSetterMockRecorder {
+	return m.recorder
+}
+
+// SetCondition mocks base method.
+func (m *MockAutoscalerConditionSetter) SetCondition(name, namespace string, condition *proto.Condition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCondition", name, namespace, condition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCondition indicates an expected call of SetCondition.
+func (mr *MockAutoscalerConditionSetterMockRecorder) SetCondition(name, namespace, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCondition", reflect.TypeOf((*MockAutoscalerConditionSetter)(nil).SetCondition), name, namespace, condition)
+}
+
+// MockAutoscalerWatch is a mock of AutoscalerWatch interface.
+type MockAutoscalerWatch struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerWatchMockRecorder
+}
+
+// MockAutoscalerWatchMockRecorder is the mock recorder for MockAutoscalerWatch.
+type MockAutoscalerWatchMockRecorder struct {
+	mock *MockAutoscalerWatch
+}
+
+// NewMockAutoscalerWatch creates a new mock instance.
+func NewMockAutoscalerWatch(ctrl *gomock.Controller) *MockAutoscalerWatch {
+	mock := &MockAutoscalerWatch{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerWatchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscalerWatch) EXPECT() *MockAutoscalerWatchMockRecorder {
+	return m.recorder
+}
+
+// ResultChan mocks base method.
+func (m *MockAutoscalerWatch) ResultChan() <-chan types.AutoscalerEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResultChan")
+	ret0, _ := ret[0].(<-chan types.AutoscalerEvent)
+	return ret0
+}
+
+// ResultChan indicates an expected call of ResultChan.
+func (mr *MockAutoscalerWatchMockRecorder) ResultChan() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResultChan", reflect.TypeOf((*MockAutoscalerWatch)(nil).ResultChan))
+}
+
+// Stop mocks base method.
+func (m *MockAutoscalerWatch) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockAutoscalerWatchMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockAutoscalerWatch)(nil).Stop))
+}
+
+// MockAutoscalerWatcher is a mock of AutoscalerWatcher interface.
+type MockAutoscalerWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerWatcherMockRecorder
+}
+
+// MockAutoscalerWatcherMockRecorder is the mock recorder for MockAutoscalerWatcher.
+type MockAutoscalerWatcherMockRecorder struct {
+	mock *MockAutoscalerWatcher
+}
+
+// NewMockAutoscalerWatcher creates a new mock instance.
+func NewMockAutoscalerWatcher(ctrl *gomock.Controller) *MockAutoscalerWatcher {
+	mock := &MockAutoscalerWatcher{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscalerWatcher) EXPECT() *MockAutoscalerWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchAutoscalers mocks base method.
+func (m *MockAutoscalerWatcher) WatchAutoscalers(namespace string) ([]*proto.Autoscaler, types.AutoscalerWatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchAutoscalers", namespace)
+	ret0, _ := ret[0].([]*proto.Autoscaler)
+	ret1, _ := ret[1].(types.AutoscalerWatch)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchAutoscalers indicates an expected call of WatchAutoscalers.
+func (mr *MockAutoscalerWatcherMockRecorder) WatchAutoscalers(namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchAutoscalers", reflect.TypeOf((*MockAutoscalerWatcher)(nil).WatchAutoscalers), namespace)
+}
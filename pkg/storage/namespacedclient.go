@@ -59,18 +59,12 @@ func (c *namespacedClient) UpdateStatus(ctx context.Context, horizontalPodAutosc
 	if err != nil {
 		return nil, err
 	}
-	entry.autoscaler.Status = status
-	horizontalPodAutoscaler.Status.DeepCopyInto(&entry.hpa.Status)
-
-	if watcher, ok := c.client.watchersByNamespace[c.namespace]; ok {
-		go func() {
-			watcher.AutoscalerStatusUpdated(entry.autoscaler)
-		}()
+	// keep conditions that are not managed by the HPA
+	for _, condition := range entry.conditions {
+		status.Conditions = append(status.Conditions, condition)
 	}
-
-	c.client.statusUpdateHandler.AutoscalerStatusUpdated(entry.autoscaler)
-
-	c.client.updateWatchesModifiedLocked(entry)
+	horizontalPodAutoscaler.Status.DeepCopyInto(&entry.hpa.Status)
+	c.client.updateStatusLocked(entry, status)
 
 	return entry.hpa, nil
 }
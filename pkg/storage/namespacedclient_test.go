@@ -25,6 +25,7 @@ func TestNamespacedClientWatches(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	autoscaler := prototypes.Autoscaler{
 		Name:      "testas",
@@ -101,6 +102,7 @@ func TestNamespacedClientWatchesAllNamespaces(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	autoscaler := prototypes.Autoscaler{
 		Name:      "testas",
@@ -177,6 +179,7 @@ func TestNamespacedClientUpdateStatus(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	autoscaler := prototypes.Autoscaler{
 		Name:      "testas",
@@ -224,6 +227,7 @@ func TestNamespacedClientErrors(t *testing.T) {
 	statusUpdateHandler := mocks.NewMockAutoscalerStatusUpdateHandler(mockCtrl)
 	client, err := NewClient(statusUpdateHandler)
 	require.NoError(t, err)
+	defer client.Close()
 
 	// get
 	_, err = client.HorizontalPodAutoscalers("none").Get(context.Background(), "none", v1.GetOptions{})
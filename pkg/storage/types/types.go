@@ -2,7 +2,13 @@
 // Licensed under the MIT License.
 package types
 
-import prototypes "k9s-autoscaler/pkg/proto"
+import (
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+
+	"k8s.io/apimachinery/pkg/watch"
+)
 
 //go:generate mockgen -package mocks -destination ../mocks/storage.go -source $GOFILE
 
@@ -38,3 +44,55 @@ type AutoscalerStatusUpdateHandler interface {
 	// Autoscaler status has been updated.
 	AutoscalerStatusUpdated(autoscaler *prototypes.Autoscaler)
 }
+
+// A point in time snapshot of an autoscaler status.
+type AutoscalerStatusSnapshot struct {
+	// Time at which status was updated.
+	Time time.Time
+	// Autoscaler status as of Time.
+	Status *prototypes.AutoscalerStatus
+}
+
+// An interface to obtain current and recent autoscaler status.
+type AutoscalerStatusGetter interface {
+	// Get current status of an autoscaler by name and namespace.
+	GetStatus(name, namespace string) (*prototypes.AutoscalerStatus, error)
+	// Get recent status snapshots of an autoscaler by name and namespace,
+	// ordered from oldest to newest.
+	GetStatusHistory(name, namespace string) ([]*AutoscalerStatusSnapshot, error)
+}
+
+// An interface to set autoscaler conditions that are not managed by the HPA,
+// such as FallbackActive.
+type AutoscalerConditionSetter interface {
+	// Sets a condition of an autoscaler by name and namespace, replacing any
+	// existing condition of the same type.
+	SetCondition(name, namespace string, condition *prototypes.Condition) error
+}
+
+// An autoscaler change event.
+type AutoscalerEvent struct {
+	// One of watch.Added, watch.Modified or watch.Deleted. Status updates are
+	// delivered as watch.Modified.
+	Type watch.EventType
+	// Autoscaler as of this event.
+	Autoscaler *prototypes.Autoscaler
+}
+
+// A watch of autoscaler change events.
+type AutoscalerWatch interface {
+	// Returns a channel that delivers change events. The channel is closed
+	// when Stop() is called, or if the consumer falls behind and events had
+	// to be dropped. In the latter case, consumers should start a new watch.
+	ResultChan() <-chan AutoscalerEvent
+	// Stops watching and releases any associated resources.
+	Stop()
+}
+
+// An interface to watch autoscaler changes.
+type AutoscalerWatcher interface {
+	// Watch changes to autoscalers in namespace, or all namespaces if empty.
+	// Returns a snapshot of current autoscalers and a watch that delivers all
+	// changes after the snapshot.
+	WatchAutoscalers(namespace string) ([]*prototypes.Autoscaler, AutoscalerWatch, error)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metricstypes "k9s-autoscaler/pkg/metrics/types"
+	prototypes "k9s-autoscaler/pkg/proto"
+	scalingtypes "k9s-autoscaler/pkg/scale/types"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/utils/clock"
+)
+
+var (
+	_ metricstypes.MetricsClient = &MetricsClient{}
+	_ scalingtypes.ScalingClient = &ScalingClient{}
+)
+
+// A fake metrics client that returns values set by callers, regardless of
+// autoscaler and metric config. Values are stamped with the time of clock.
+type MetricsClient struct {
+	clock       clock.PassiveClock
+	lock        sync.Mutex
+	values      map[string]float64
+	unavailable map[string]bool
+}
+
+// A fake scaling client that applies scale immediately.
+type ScalingClient struct {
+	lock  sync.Mutex
+	scale int32
+}
+
+// Creates a new fake metrics client with no metric values.
+func NewMetricsClient(clock clock.PassiveClock) *MetricsClient {
+	return &MetricsClient{
+		clock:       clock,
+		values:      make(map[string]float64),
+		unavailable: make(map[string]bool),
+	}
+}
+
+// Sets value of metric name.
+func (c *MetricsClient) Set(name string, value float64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.values[name] = value
+}
+
+// Sets whether reading metric name fails.
+func (c *MetricsClient) SetUnavailable(name string, unavailable bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.unavailable[name] = unavailable
+}
+
+// Returns current value of metricName. Fails if the metric is unavailable or
+// its value was never set.
+// Implements metricstypes.MetricsClient.
+func (c *MetricsClient) GetMetric(ctx context.Context, metricName, autoscalerName, namespace string, config *anypb.Any) ([]float64, time.Time, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.unavailable[metricName] {
+		return nil, time.Time{}, fmt.Errorf("metric %s is unavailable", metricName)
+	}
+	value, ok := c.values[metricName]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("metric %s is not set", metricName)
+	}
+
+	return []float64{value}, c.clock.Now(), nil
+}
+
+// Creates a new fake scaling client with initial scale.
+func NewScalingClient(scale int32) *ScalingClient {
+	return &ScalingClient{scale: scale}
+}
+
+// Returns current scale.
+func (c *ScalingClient) Scale() int32 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.scale
+}
+
+// Sets current scale, for example to simulate a manual change.
+func (c *ScalingClient) SetScale(scale int32) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.scale = scale
+}
+
+// Implements scalingtypes.ScalingClient.
+func (c *ScalingClient) SetScaleTarget(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget, target *prototypes.ScaleSpec) error {
+	c.SetScale(target.Desired)
+
+	return nil
+}
+
+// Implements scalingtypes.ScalingClient.
+func (c *ScalingClient) GetScale(ctx context.Context, name, namespace string, scaleTarget *prototypes.AutoscalerTarget) (*prototypes.Scale, error) {
+	scale := c.Scale()
+
+	return &prototypes.Scale{
+		Spec:   &prototypes.ScaleSpec{Desired: scale},
+		Status: &prototypes.ScaleStatus{Current: scale},
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k9s-autoscaler/pkg/autoscaler"
+	autoscalertypes "k9s-autoscaler/pkg/autoscaler/types"
+	"k9s-autoscaler/pkg/events"
+	"k9s-autoscaler/pkg/metrics"
+	prototypes "k9s-autoscaler/pkg/proto"
+	"k9s-autoscaler/pkg/scale"
+	"k9s-autoscaler/pkg/storage"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/klog/v2"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// Harness options.
+type Options struct {
+	// Autoscaler under test. Metric configs are not required.
+	Autoscaler *prototypes.Autoscaler
+	// Controller configs, same as ControllerConfig.
+	ResyncPeriod                 time.Duration
+	DownscaleStabilizationWindow time.Duration
+	Tolerance                    float64
+	// Scale at start. Defaults to autoscaler min.
+	InitialScale int32
+}
+
+// Runs the autoscaler controller in-process with fake metrics and scaling
+// clients on a fake clock. The controller does not run in the background;
+// advancing the clock syncs the HPA every resync period, starting one resync
+// period after Start(), such that scale at any clock time is deterministic.
+type Harness struct {
+	// Fake clock of the harness. Advance it with Advance() or WaitForScale()
+	// to run HPA syncs.
+	Clock *testingclock.FakeClock
+	// Fake metrics client read by the autoscaler.
+	Metrics *MetricsClient
+	// Fake scaling client of the autoscaler target.
+	Scaling *ScalingClient
+	// Storage holding the autoscaler under test.
+	Storage *storage.Client
+
+	opts       Options
+	autoscaler *prototypes.Autoscaler
+	controller autoscalertypes.Controller
+	nextSync   time.Time
+}
+
+// Creates a new harness with opts. Call Start() to start the controller.
+func NewHarness(opts Options) (*Harness, error) {
+	if opts.Autoscaler == nil || opts.Autoscaler.Spec == nil {
+		return nil, fmt.Errorf("autoscaler must be specified")
+	}
+	if opts.ResyncPeriod <= 0 {
+		return nil, fmt.Errorf("resync period must be > 0")
+	}
+	as := proto.Clone(opts.Autoscaler).(*prototypes.Autoscaler)
+	// all metrics are read from the fake client, which needs no configs.
+	metricConfig, err := anypb.New(&emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	for _, metric := range as.Spec.Metrics {
+		if metric.Config == nil {
+			metric.Config = metricConfig
+		}
+	}
+
+	storageClient := storage.NewClientWithoutMetrics(&statusUpdateHandler{})
+	if err := storageClient.Add(as); err != nil {
+		return nil, err
+	}
+	initialScale := opts.InitialScale
+	if initialScale == 0 {
+		initialScale = as.Spec.Min
+	}
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+
+	return &Harness{
+		Clock:      fakeClock,
+		Metrics:    NewMetricsClient(fakeClock),
+		Scaling:    NewScalingClient(initialScale),
+		Storage:    storageClient,
+		opts:       opts,
+		autoscaler: as,
+	}, nil
+}
+
+// Starts the autoscaler controller. Its first sync is one resync period
+// later in clock time.
+func (h *Harness) Start() {
+	h.controller = autoscaler.NewControllerWithClock(
+		h.Storage,
+		events.NewGetter(nil),
+		scale.NewGetter(h.Storage, h.Scaling),
+		metrics.NewClientWithClock(h.Storage, h.Metrics, nil, h.Clock),
+		h.opts.ResyncPeriod,
+		h.opts.DownscaleStabilizationWindow,
+		h.opts.Tolerance,
+		h.Clock)
+	h.nextSync = h.Clock.Now().Add(h.opts.ResyncPeriod)
+}
+
+// Stops the autoscaler controller. Advancing the clock no longer syncs the
+// HPA.
+func (h *Harness) Stop() {
+	h.controller = nil
+}
+
+// Advances the clock by d, syncing the HPA at every resync period on the way
+// including one due at the end of d.
+func (h *Harness) Advance(d time.Duration) {
+	end := h.Clock.Now().Add(d)
+	for h.controller != nil && !h.nextSync.After(end) {
+		h.Clock.SetTime(h.nextSync)
+		if err := h.controller.Sync(context.Background()); err != nil {
+			klog.V(4).InfoS("harness sync failed", "autoscaler", h.autoscaler.Name, "namespace", h.autoscaler.Namespace, "error", err)
+		}
+		h.nextSync = h.nextSync.Add(h.opts.ResyncPeriod)
+	}
+	h.Clock.SetTime(end)
+}
+
+// Returns current scale of the autoscaler target.
+func (h *Harness) Scale() int32 {
+	return h.Scaling.Scale()
+}
+
+// Returns desired scale last calculated by the HPA, or 0 if it did not run
+// yet.
+func (h *Harness) DesiredScale() int32 {
+	status, err := h.Storage.GetStatus(h.autoscaler.Name, h.autoscaler.Namespace)
+	if err != nil || status == nil {
+		return 0
+	}
+
+	return status.DesiredScale
+}
+
+// Advances the clock up to timeout until scale satisfies condition. Scale is
+// checked at the current time and after every HPA sync, and the clock stops
+// at the sync that satisfied condition or at timeout. Returns the last scale
+// and whether condition was satisfied.
+func (h *Harness) WaitForScale(condition func(scale int32) bool, timeout time.Duration) (int32, bool) {
+	deadline := h.Clock.Now().Add(timeout)
+	for {
+		scale := h.Scale()
+		if condition(scale) {
+			return scale, true
+		}
+		now := h.Clock.Now()
+		if !now.Before(deadline) {
+			return scale, false
+		}
+		next := deadline.Sub(now)
+		if h.controller != nil && h.nextSync.Before(deadline) {
+			next = h.nextSync.Sub(now)
+		}
+		h.Advance(next)
+	}
+}
+
+// A no-op status update handler.
+type statusUpdateHandler struct{}
+
+func (h *statusUpdateHandler) AutoscalerStatusUpdated(autoscaler *prototypes.Autoscaler) {
+}
@@ -0,0 +1,467 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.0--rc2
+// source: scenario.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	proto "k9s-autoscaler/pkg/proto"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Define a declarative autoscaler test scenario. Steps are run in order
+// against the real autoscaler controller using fake metrics and scaling
+// providers.
+type Scenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scenario name used in reports.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Autoscaler under test. Metric configs are not required.
+	Autoscaler *proto.Autoscaler `protobuf:"bytes,2,opt,name=autoscaler,proto3" json:"autoscaler,omitempty"`
+	// Scale at the start of the scenario. Defaults to autoscaler min.
+	InitialScale int32 `protobuf:"varint,3,opt,name=initial_scale,json=initialScale,proto3" json:"initial_scale,omitempty"`
+	// Controller configs, same as ControllerConfig. Default to 15s, 5m and
+	// 0.1 respectively.
+	ResyncPeriod                 *durationpb.Duration `protobuf:"bytes,4,opt,name=resync_period,json=resyncPeriod,proto3,oneof" json:"resync_period,omitempty"`
+	DownscaleStabilizationWindow *durationpb.Duration `protobuf:"bytes,5,opt,name=downscale_stabilization_window,json=downscaleStabilizationWindow,proto3,oneof" json:"downscale_stabilization_window,omitempty"`
+	Tolerance                    *float64             `protobuf:"fixed64,6,opt,name=tolerance,proto3,oneof" json:"tolerance,omitempty"`
+	// Steps to run in order.
+	Steps []*ScenarioStep `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scenario_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_scenario_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_scenario_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetAutoscaler() *proto.Autoscaler {
+	if x != nil {
+		return x.Autoscaler
+	}
+	return nil
+}
+
+func (x *Scenario) GetInitialScale() int32 {
+	if x != nil {
+		return x.InitialScale
+	}
+	return 0
+}
+
+func (x *Scenario) GetResyncPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.ResyncPeriod
+	}
+	return nil
+}
+
+func (x *Scenario) GetDownscaleStabilizationWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DownscaleStabilizationWindow
+	}
+	return nil
+}
+
+func (x *Scenario) GetTolerance() float64 {
+	if x != nil && x.Tolerance != nil {
+		return *x.Tolerance
+	}
+	return 0
+}
+
+func (x *Scenario) GetSteps() []*ScenarioStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// Define a scenario step that holds metric values for a duration.
+type ScenarioStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Metric values to set at the start of the step, by metric name. Metrics
+	// not set keep their previous values.
+	Metrics map[string]float64 `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Metrics that fail to be read during the step. Metrics not listed are
+	// available.
+	UnavailableMetrics []string `protobuf:"bytes,2,rep,name=unavailable_metrics,json=unavailableMetrics,proto3" json:"unavailable_metrics,omitempty"`
+	// Duration of the step.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Expectations of the step.
+	Expect []*ScenarioExpectation `protobuf:"bytes,4,rep,name=expect,proto3" json:"expect,omitempty"`
+}
+
+func (x *ScenarioStep) Reset() {
+	*x = ScenarioStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scenario_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScenarioStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioStep) ProtoMessage() {}
+
+func (x *ScenarioStep) ProtoReflect() protoreflect.Message {
+	mi := &file_scenario_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioStep.ProtoReflect.Descriptor instead.
+func (*ScenarioStep) Descriptor() ([]byte, []int) {
+	return file_scenario_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScenarioStep) GetMetrics() map[string]float64 {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *ScenarioStep) GetUnavailableMetrics() []string {
+	if x != nil {
+		return x.UnavailableMetrics
+	}
+	return nil
+}
+
+func (x *ScenarioStep) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ScenarioStep) GetExpect() []*ScenarioExpectation {
+	if x != nil {
+		return x.Expect
+	}
+	return nil
+}
+
+// Define an expectation of applied scale. If within is set, the expectation
+// must be met at some point within that duration from the start of the step,
+// otherwise it must be met at the end of the step.
+type ScenarioExpectation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Expected scale.
+	Scale *int32 `protobuf:"varint,1,opt,name=scale,proto3,oneof" json:"scale,omitempty"`
+	// Expected minimum scale.
+	MinScale *int32 `protobuf:"varint,2,opt,name=min_scale,json=minScale,proto3,oneof" json:"min_scale,omitempty"`
+	// Expected maximum scale.
+	MaxScale *int32               `protobuf:"varint,3,opt,name=max_scale,json=maxScale,proto3,oneof" json:"max_scale,omitempty"`
+	Within   *durationpb.Duration `protobuf:"bytes,4,opt,name=within,proto3,oneof" json:"within,omitempty"`
+}
+
+func (x *ScenarioExpectation) Reset() {
+	*x = ScenarioExpectation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scenario_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScenarioExpectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioExpectation) ProtoMessage() {}
+
+func (x *ScenarioExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_scenario_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioExpectation.ProtoReflect.Descriptor instead.
+func (*ScenarioExpectation) Descriptor() ([]byte, []int) {
+	return file_scenario_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScenarioExpectation) GetScale() int32 {
+	if x != nil && x.Scale != nil {
+		return *x.Scale
+	}
+	return 0
+}
+
+func (x *ScenarioExpectation) GetMinScale() int32 {
+	if x != nil && x.MinScale != nil {
+		return *x.MinScale
+	}
+	return 0
+}
+
+func (x *ScenarioExpectation) GetMaxScale() int32 {
+	if x != nil && x.MaxScale != nil {
+		return *x.MaxScale
+	}
+	return 0
+}
+
+func (x *ScenarioExpectation) GetWithin() *durationpb.Duration {
+	if x != nil {
+		return x.Within
+	}
+	return nil
+}
+
+var File_scenario_proto protoreflect.FileDescriptor
+
+var file_scenario_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x1b, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x03, 0x0a, 0x08, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x1e, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x1c, 0x64, 0x6f, 0x77, 0x6e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02,
+	0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xce, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x39, 0x73,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x75,
+	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x39, 0x73, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x53, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x36, 0x0a, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x06, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x42, 0x28, 0x5a, 0x26, 0x6b, 0x39, 0x73,
+	0x2d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_scenario_proto_rawDescOnce sync.Once
+	file_scenario_proto_rawDescData = file_scenario_proto_rawDesc
+)
+
+func file_scenario_proto_rawDescGZIP() []byte {
+	file_scenario_proto_rawDescOnce.Do(func() {
+		file_scenario_proto_rawDescData = protoimpl.X.CompressGZIP(file_scenario_proto_rawDescData)
+	})
+	return file_scenario_proto_rawDescData
+}
+
+var file_scenario_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_scenario_proto_goTypes = []interface{}{
+	(*Scenario)(nil),            // 0: k9sautoscaler.testing.proto.Scenario
+	(*ScenarioStep)(nil),        // 1: k9sautoscaler.testing.proto.ScenarioStep
+	(*ScenarioExpectation)(nil), // 2: k9sautoscaler.testing.proto.ScenarioExpectation
+	nil,                         // 3: k9sautoscaler.testing.proto.ScenarioStep.MetricsEntry
+	(*proto.Autoscaler)(nil),    // 4: k9sautoscaler.proto.Autoscaler
+	(*durationpb.Duration)(nil), // 5: google.protobuf.Duration
+}
+var file_scenario_proto_depIdxs = []int32{
+	4, // 0: k9sautoscaler.testing.proto.Scenario.autoscaler:type_name -> k9sautoscaler.proto.Autoscaler
+	5, // 1: k9sautoscaler.testing.proto.Scenario.resync_period:type_name -> google.protobuf.Duration
+	5, // 2: k9sautoscaler.testing.proto.Scenario.downscale_stabilization_window:type_name -> google.protobuf.Duration
+	1, // 3: k9sautoscaler.testing.proto.Scenario.steps:type_name -> k9sautoscaler.testing.proto.ScenarioStep
+	3, // 4: k9sautoscaler.testing.proto.ScenarioStep.metrics:type_name -> k9sautoscaler.testing.proto.ScenarioStep.MetricsEntry
+	5, // 5: k9sautoscaler.testing.proto.ScenarioStep.duration:type_name -> google.protobuf.Duration
+	2, // 6: k9sautoscaler.testing.proto.ScenarioStep.expect:type_name -> k9sautoscaler.testing.proto.ScenarioExpectation
+	5, // 7: k9sautoscaler.testing.proto.ScenarioExpectation.within:type_name -> google.protobuf.Duration
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_scenario_proto_init() }
+func file_scenario_proto_init() {
+	if File_scenario_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_scenario_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scenario_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScenarioStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scenario_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScenarioExpectation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_scenario_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_scenario_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scenario_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_scenario_proto_goTypes,
+		DependencyIndexes: file_scenario_proto_depIdxs,
+		MessageInfos:      file_scenario_proto_msgTypes,
+	}.Build()
+	File_scenario_proto = out.File
+	file_scenario_proto_rawDesc = nil
+	file_scenario_proto_goTypes = nil
+	file_scenario_proto_depIdxs = nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package testing
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	testingproto "k9s-autoscaler/pkg/testing/proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultResyncPeriod                 = 15 * time.Second
+	defaultDownscaleStabilizationWindow = 5 * time.Minute
+	defaultTolerance                    = 0.1
+)
+
+// Subset of testing.T used to report scenario failures.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	FailNow()
+}
+
+// Loads a scenario from a yaml file.
+// see: pkg/testing/proto/scenario.proto
+func LoadScenario(path string) (*testingproto.Scenario, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	jsonBytes, err := yaml.YAMLToJSON(bytes)
+	if err != nil {
+		return nil, err
+	}
+	scenario := &testingproto.Scenario{}
+	if err := protojson.Unmarshal(jsonBytes, scenario); err != nil {
+		return nil, fmt.Errorf("failed to process scenario %s: %v", path, err)
+	}
+
+	return scenario, nil
+}
+
+// Runs scenario against the autoscaler controller. Returns messages of failed
+// expectations, or an error if the scenario is invalid.
+func RunScenario(scenario *testingproto.Scenario) ([]string, error) {
+	if err := validateScenario(scenario); err != nil {
+		return nil, err
+	}
+	opts := Options{
+		Autoscaler:                   scenario.Autoscaler,
+		ResyncPeriod:                 defaultResyncPeriod,
+		DownscaleStabilizationWindow: defaultDownscaleStabilizationWindow,
+		Tolerance:                    defaultTolerance,
+		InitialScale:                 scenario.InitialScale,
+	}
+	if scenario.ResyncPeriod != nil {
+		opts.ResyncPeriod = scenario.ResyncPeriod.AsDuration()
+	}
+	if scenario.DownscaleStabilizationWindow != nil {
+		opts.DownscaleStabilizationWindow = scenario.DownscaleStabilizationWindow.AsDuration()
+	}
+	if scenario.Tolerance != nil {
+		opts.Tolerance = *scenario.Tolerance
+	}
+	harness, err := NewHarness(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.InfoS("running scenario", "scenario", scenario.Name, "steps", len(scenario.Steps))
+	harness.Start()
+	defer harness.Stop()
+
+	failures := []string{}
+	for i, step := range scenario.Steps {
+		failures = append(failures, runStep(harness, i, step)...)
+	}
+
+	return failures, nil
+}
+
+// Loads and runs the scenario at path, reporting failed expectations to t.
+func RunScenarioFile(t TestingT, path string) {
+	t.Helper()
+
+	scenario, err := LoadScenario(path)
+	if err != nil {
+		t.Errorf("%v", err)
+		t.FailNow()
+		return
+	}
+	failures, err := RunScenario(scenario)
+	if err != nil {
+		t.Errorf("scenario %s: %v", scenario.Name, err)
+		t.FailNow()
+		return
+	}
+	for _, failure := range failures {
+		t.Errorf("scenario %s: %s", scenario.Name, failure)
+	}
+}
+
+// Runs step i and returns messages of its failed expectations.
+func runStep(harness *Harness, i int, step *testingproto.ScenarioStep) []string {
+	for name, value := range step.Metrics {
+		harness.Metrics.Set(name, value)
+	}
+	unavailable := make(map[string]bool)
+	for _, name := range step.UnavailableMetrics {
+		unavailable[name] = true
+	}
+	for _, metric := range harness.autoscaler.Spec.Metrics {
+		harness.Metrics.SetUnavailable(metric.Name, unavailable[metric.Name])
+	}
+
+	// expectations with within are met once at any time during the step,
+	// scale is checked at its start and after every HPA sync.
+	met := make([]bool, len(step.Expect))
+	start := harness.Clock.Now()
+	duration := step.Duration.AsDuration()
+	harness.WaitForScale(func(scale int32) bool {
+		elapsed := harness.Clock.Since(start)
+		for j, expectation := range step.Expect {
+			if expectation.Within != nil && elapsed <= expectation.Within.AsDuration() && expectationMet(expectation, scale) {
+				met[j] = true
+			}
+		}
+		return false
+	}, duration)
+
+	failures := []string{}
+	scale := harness.Scale()
+	for j, expectation := range step.Expect {
+		if expectation.Within != nil {
+			if !met[j] {
+				failures = append(failures, fmt.Sprintf("step %d: expected %s within %v, scale is %d",
+					i, describeExpectation(expectation), expectation.Within.AsDuration(), scale))
+			}
+		} else if !expectationMet(expectation, scale) {
+			failures = append(failures, fmt.Sprintf("step %d: expected %s after %v, scale is %d",
+				i, describeExpectation(expectation), duration, scale))
+		}
+	}
+
+	return failures
+}
+
+func validateScenario(scenario *testingproto.Scenario) error {
+	if scenario.Autoscaler == nil || scenario.Autoscaler.Spec == nil {
+		return fmt.Errorf("autoscaler must be specified")
+	}
+	metrics := make(map[string]bool)
+	for _, metric := range scenario.Autoscaler.Spec.Metrics {
+		metrics[metric.Name] = true
+	}
+	for i, step := range scenario.Steps {
+		duration := step.Duration.AsDuration()
+		if duration <= 0 {
+			return fmt.Errorf("step %d: duration must be > 0", i)
+		}
+		for name := range step.Metrics {
+			if !metrics[name] {
+				return fmt.Errorf("step %d: unknown metric %s", i, name)
+			}
+		}
+		for _, name := range step.UnavailableMetrics {
+			if !metrics[name] {
+				return fmt.Errorf("step %d: unknown metric %s", i, name)
+			}
+		}
+		for _, expectation := range step.Expect {
+			if expectation.Scale == nil && expectation.MinScale == nil && expectation.MaxScale == nil {
+				return fmt.Errorf("step %d: expectation must set scale, minScale or maxScale", i)
+			}
+			if expectation.Within != nil && expectation.Within.AsDuration() > duration {
+				return fmt.Errorf("step %d: expectation within %v is longer than step", i, expectation.Within.AsDuration())
+			}
+		}
+	}
+
+	return nil
+}
+
+func expectationMet(expectation *testingproto.ScenarioExpectation, scale int32) bool {
+	if expectation.Scale != nil && scale != *expectation.Scale {
+		return false
+	}
+	if expectation.MinScale != nil && scale < *expectation.MinScale {
+		return false
+	}
+	if expectation.MaxScale != nil && scale > *expectation.MaxScale {
+		return false
+	}
+
+	return true
+}
+
+func describeExpectation(expectation *testingproto.ScenarioExpectation) string {
+	parts := []string{}
+	if expectation.Scale != nil {
+		parts = append(parts, fmt.Sprintf("scale %d", *expectation.Scale))
+	}
+	if expectation.MinScale != nil {
+		parts = append(parts, fmt.Sprintf("scale >= %d", *expectation.MinScale))
+	}
+	if expectation.MaxScale != nil {
+		parts = append(parts, fmt.Sprintf("scale <= %d", *expectation.MaxScale))
+	}
+
+	return strings.Join(parts, " and ")
+}
@@ -0,0 +1,180 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package testing
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+	"time"
+
+	prototypes "k9s-autoscaler/pkg/proto"
+	testingproto "k9s-autoscaler/pkg/testing/proto"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Records reported failures.
+type recordingT struct {
+	errors []string
+	failed bool
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) FailNow() {
+	t.failed = true
+}
+
+func int32Pointer(i int32) *int32 {
+	return &i
+}
+
+func newTestScenario(name string) *testingproto.Scenario {
+	return &testingproto.Scenario{
+		Name: name,
+		Autoscaler: &prototypes.Autoscaler{
+			Name:      name,
+			Namespace: name,
+			Spec: &prototypes.AutoscalerSpec{
+				Min: 1,
+				Max: 10,
+				Metrics: []*prototypes.Metric{
+					{
+						Name:       "load",
+						Target:     10,
+						TargetType: prototypes.Metric_AverageValue,
+					},
+				},
+			},
+		},
+		ResyncPeriod:                 durationpb.New(15 * time.Second),
+		DownscaleStabilizationWindow: durationpb.New(time.Minute),
+	}
+}
+
+func TestRunScenarioFile(t *stdtesting.T) {
+	RunScenarioFile(t, "../../examples/scenarios/rps.yaml")
+}
+
+func TestRunScenarioFailures(t *stdtesting.T) {
+	scenario := newTestScenario(t.Name())
+	scenario.Steps = []*testingproto.ScenarioStep{
+		{
+			Metrics:  map[string]float64{"load": 30},
+			Duration: durationpb.New(time.Minute),
+			Expect: []*testingproto.ScenarioExpectation{
+				{Scale: int32Pointer(3), Within: durationpb.New(45 * time.Second)},
+				{MinScale: int32Pointer(5), Within: durationpb.New(45 * time.Second)},
+				{MaxScale: int32Pointer(2)},
+			},
+		},
+	}
+
+	failures, err := RunScenario(scenario)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"step 0: expected scale >= 5 within 45s, scale is 3",
+		"step 0: expected scale <= 2 after 1m0s, scale is 3",
+	}, failures)
+}
+
+func TestRunScenarioBehavior(t *stdtesting.T) {
+	scenario := newTestScenario(t.Name())
+	scenario.Autoscaler.Spec.Behavior = &prototypes.Behavior{
+		ScaleUp: &prototypes.ScalingRules{
+			StabilizationWindowSeconds: int32Pointer(0),
+			Policies:                   []*prototypes.ScalingPolicy{{Value: 2, PeriodSeconds: 45}},
+		},
+		ScaleDown: &prototypes.ScalingRules{
+			StabilizationWindowSeconds: int32Pointer(0),
+			Policies:                   []*prototypes.ScalingPolicy{{ValueType: prototypes.ScalingPolicy_Percent, Value: 100, PeriodSeconds: 15}},
+		},
+	}
+	// scale up is limited to 2 per 45s
+	scenario.Steps = []*testingproto.ScenarioStep{
+		{
+			Metrics:  map[string]float64{"load": 50},
+			Duration: durationpb.New(time.Minute),
+			Expect: []*testingproto.ScenarioExpectation{
+				{Scale: int32Pointer(3), Within: durationpb.New(15 * time.Second)},
+				{Scale: int32Pointer(5)},
+			},
+		},
+		{
+			Metrics:  map[string]float64{"load": 10},
+			Duration: durationpb.New(15 * time.Second),
+			Expect:   []*testingproto.ScenarioExpectation{{Scale: int32Pointer(1)}},
+		},
+	}
+
+	failures, err := RunScenario(scenario)
+	require.NoError(t, err)
+	require.Empty(t, failures)
+}
+
+func TestRunScenarioInvalid(t *stdtesting.T) {
+	for _, step := range []*testingproto.ScenarioStep{
+		{},
+		{Duration: durationpb.New(time.Minute), Metrics: map[string]float64{"other": 1}},
+		{Duration: durationpb.New(time.Minute), UnavailableMetrics: []string{"other"}},
+		{Duration: durationpb.New(time.Minute), Expect: []*testingproto.ScenarioExpectation{{}}},
+		{Duration: durationpb.New(time.Minute), Expect: []*testingproto.ScenarioExpectation{
+			{Scale: int32Pointer(1), Within: durationpb.New(2 * time.Minute)},
+		}},
+	} {
+		scenario := newTestScenario(t.Name())
+		scenario.Steps = []*testingproto.ScenarioStep{step}
+		_, err := RunScenario(scenario)
+		require.Error(t, err, step)
+	}
+
+	recorder := &recordingT{}
+	path := filepath.Join(t.TempDir(), "scenario.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("unknown: 1\n"), 0600))
+	RunScenarioFile(recorder, path)
+	require.True(t, recorder.failed)
+	require.Len(t, recorder.errors, 1)
+}
+
+func TestHarness(t *stdtesting.T) {
+	scenario := newTestScenario(t.Name())
+	harness, err := NewHarness(Options{
+		Autoscaler:   scenario.Autoscaler,
+		ResyncPeriod: 15 * time.Second,
+		InitialScale: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), harness.Scale())
+
+	harness.Metrics.Set("load", 50)
+	harness.Start()
+	defer harness.Stop()
+	start := harness.Clock.Now()
+
+	// first sync is one resync period after start
+	harness.Advance(14 * time.Second)
+	require.Equal(t, int32(2), harness.Scale())
+	scale, ok := harness.WaitForScale(func(scale int32) bool { return scale == 5 }, time.Minute)
+	require.True(t, ok, scale)
+	require.Equal(t, int32(5), harness.DesiredScale())
+	// default scale up limit is 4 from 2
+	require.Equal(t, 30*time.Second, harness.Clock.Since(start))
+
+	// manual scale changes are reverted on the next sync
+	harness.Scaling.SetScale(3)
+	scale, ok = harness.WaitForScale(func(scale int32) bool { return scale == 5 }, time.Minute)
+	require.True(t, ok, scale)
+	require.Equal(t, 45*time.Second, harness.Clock.Since(start))
+
+	scale, ok = harness.WaitForScale(func(scale int32) bool { return scale == 1 }, time.Minute)
+	require.False(t, ok)
+	require.Equal(t, int32(5), scale)
+	require.Equal(t, 105*time.Second, harness.Clock.Since(start))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package timeseries
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A point of a recorded time series.
+type Point struct {
+	// Offset from the first point.
+	Offset time.Duration
+	Value  float64
+}
+
+// Reads a recorded time series from a CSV or JSON file, by extension. CSV
+// rows are "time,value" with an optional header row. JSON is an array of
+// objects with "time" and valueName fields. Time is either seconds since
+// start of the series or an RFC3339 timestamp, in which case it is relative
+// to the first point. Returned points are sorted by offset, with the first
+// point at offset 0.
+func Read(path, valueName string) ([]Point, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open time series: %v", err)
+	}
+	defer file.Close()
+
+	var times []string
+	var values []float64
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		times, values, err = readCSV(file)
+	case ".json":
+		times, values, err = readJSON(file, valueName)
+	default:
+		return nil, fmt.Errorf("unsupported time series file %s, expected .csv or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read time series %s: %v", path, err)
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf("time series %s is empty", path)
+	}
+
+	points := make([]Point, len(times))
+	var start time.Time
+	for i, t := range times {
+		if seconds, err := strconv.ParseFloat(t, 64); err == nil {
+			if !start.IsZero() {
+				return nil, fmt.Errorf("time series %s mixes offsets and timestamps", path)
+			}
+			points[i] = Point{Offset: time.Duration(seconds * float64(time.Second)), Value: values[i]}
+			continue
+		}
+		timestamp, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %s in time series %s", t, path)
+		}
+		if i == 0 {
+			start = timestamp
+		} else if start.IsZero() {
+			return nil, fmt.Errorf("time series %s mixes offsets and timestamps", path)
+		}
+		points[i] = Point{Offset: timestamp.Sub(start), Value: values[i]}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Offset < points[j].Offset
+	})
+	first := points[0].Offset
+	for i := range points {
+		points[i].Offset -= first
+	}
+
+	return points, nil
+}
+
+// Returns value of points at offset. If linear, values between points are
+// linearly interpolated, otherwise each point holds until the next. Offsets
+// before the first point or after the last point hold their values.
+func ValueAt(points []Point, offset time.Duration, linear bool) float64 {
+	// index of first point after offset
+	i := sort.Search(len(points), func(i int) bool {
+		return points[i].Offset > offset
+	})
+	if i == 0 {
+		return points[0].Value
+	}
+	if i == len(points) {
+		return points[i-1].Value
+	}
+	prev, next := points[i-1], points[i]
+	if !linear {
+		return prev.Value
+	}
+
+	return prev.Value + (next.Value-prev.Value)*float64(offset-prev.Offset)/float64(next.Offset-prev.Offset)
+}
+
+func readCSV(reader io.Reader) ([]string, []float64, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	times := []string{}
+	values := []float64{}
+	for i, record := range records {
+		value, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			if i == 0 {
+				// header
+				continue
+			}
+			return nil, nil, fmt.Errorf("invalid value %s at row %d", record[1], i+1)
+		}
+		times = append(times, record[0])
+		values = append(values, value)
+	}
+
+	return times, values, nil
+}
+
+func readJSON(reader io.Reader, valueName string) ([]string, []float64, error) {
+	points := []map[string]json.RawMessage{}
+	if err := json.NewDecoder(reader).Decode(&points); err != nil {
+		return nil, nil, err
+	}
+
+	times := make([]string, len(points))
+	values := make([]float64, len(points))
+	for i, point := range points {
+		// time is either a number or a string
+		var t string
+		if err := json.Unmarshal(point["time"], &t); err != nil {
+			t = string(point["time"])
+		}
+		times[i] = t
+		if err := json.Unmarshal(point[valueName], &values[i]); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s of point %d: %v", valueName, i, err)
+		}
+	}
+
+	return times, values, nil
+}
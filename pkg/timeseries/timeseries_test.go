@@ -0,0 +1,68 @@
+// Copyright (c) technicianted. All rights reserved.
+// Licensed under the MIT License.
+package timeseries
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+	expected := []Point{
+		{Offset: 0, Value: 1},
+		{Offset: 90 * time.Second, Value: 2.5},
+		{Offset: 120 * time.Second, Value: 0},
+	}
+
+	for _, path := range []string{
+		write("offsets.csv", "time,value\n10,1\n100,2.5\n130,0\n"),
+		write("timestamps.csv", "2024-01-01T00:01:30Z,2.5\n2024-01-01T00:00:00Z,1\n2024-01-01T00:02:00Z,0\n"),
+		write("offsets.json", `[{"time": 0, "value": 1}, {"time": 90, "value": 2.5}, {"time": 120, "value": 0}]`),
+		write("timestamps.json", `[{"time": "2024-01-01T00:00:00Z", "value": 1}, {"time": "2024-01-01T00:01:30Z", "value": 2.5}, {"time": "2024-01-01T00:02:00Z", "value": 0}]`),
+	} {
+		points, err := Read(path, "value")
+		require.NoError(t, err, path)
+		require.Equal(t, expected, points, path)
+	}
+
+	for _, path := range []string{
+		write("bad.csv", "0,1\n10,x\n"),
+		write("columns.csv", "0,1,2\n"),
+		write("empty.csv", ""),
+		write("mixed.csv", "2024-01-01T00:00:00Z,1\n10,2\n"),
+		write("badtime.csv", "0,1\nyesterday,2\n"),
+		write("bad.json", `[{"time": 0, "load": 1}]`),
+		write("series.txt", "0,1\n"),
+		filepath.Join(dir, "missing.csv"),
+	} {
+		_, err := Read(path, "value")
+		require.Error(t, err, path)
+	}
+}
+
+func TestValueAt(t *testing.T) {
+	points := []Point{
+		{Offset: 0, Value: 10},
+		{Offset: 10 * time.Second, Value: 20},
+		{Offset: 30 * time.Second, Value: 0},
+	}
+
+	require.Equal(t, 10.0, ValueAt(points, -time.Second, false))
+	require.Equal(t, 10.0, ValueAt(points, 5*time.Second, false))
+	require.Equal(t, 20.0, ValueAt(points, 10*time.Second, false))
+	require.Equal(t, 0.0, ValueAt(points, time.Minute, false))
+
+	require.Equal(t, 15.0, ValueAt(points, 5*time.Second, true))
+	require.Equal(t, 10.0, ValueAt(points, 20*time.Second, true))
+	require.Equal(t, 0.0, ValueAt(points, time.Minute, true))
+}